@@ -12,6 +12,9 @@ func main() {
 	serve := cmd.NewServeCommand()
 	root.AddCommand(serve)
 
+	migrate := cmd.NewMigrateCommand()
+	root.AddCommand(migrate)
+
 	if err := root.Execute(); err != nil {
 		os.Exit(1)
 	}
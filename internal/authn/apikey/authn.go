@@ -0,0 +1,138 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/ristretto/v2"
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// KeyAuthn - Authenticates callers with the API keys of the ApiKeyService. Looked up keys are cached for the
+// configured TTL, keys revoked or rotated through this instance are evicted at once, other instances keep
+// accepting them for up to the TTL.
+type KeyAuthn struct {
+	store    storage.ApiKeyStore
+	fallback authn.Authenticator
+	cache    *ristretto.Cache[string, storage.ApiKey]
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu sync.Mutex
+	// generation is bumped by Forget, lookups that started before do not cache what they read
+	generation uint64
+}
+
+// NewKeyAuthn - Creates new API key authenticator, bearer tokens that are not API key secrets are passed
+// to the fallback when one is given
+func NewKeyAuthn(store storage.ApiKeyStore, cfg config.ApiKey, fallback authn.Authenticator) (*KeyAuthn, error) {
+	if cfg.CacheEntries < 1 {
+		return nil, errors.New("api key authn must cache at least one key")
+	}
+	cache, err := ristretto.NewCache(&ristretto.Config[string, storage.ApiKey]{
+		NumCounters: cfg.CacheEntries * 10,
+		MaxCost:     cfg.CacheEntries,
+		BufferItems: 64,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &KeyAuthn{
+		store:    store,
+		fallback: fallback,
+		cache:    cache,
+		ttl:      cfg.CacheTTL,
+		now:      time.Now,
+	}, nil
+}
+
+// Authenticate - Checking whether the bearer token is the secret of a valid API key, the id, tenant and roles
+// of the key are injected into the context
+func (a *KeyAuthn) Authenticate(ctx context.Context) (context.Context, error) {
+	secret, err := grpcAuth.AuthFromMD(ctx, "Bearer")
+	if err != nil {
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN)
+	}
+	if !IsSecret(secret) {
+		if a.fallback != nil {
+			return a.fallback.Authenticate(ctx)
+		}
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_INVALID_KEY)
+	}
+
+	key, err := a.lookup(ctx, Hash(secret))
+	if err != nil {
+		return nil, err
+	}
+	if !key.Valid(a.now()) {
+		slog.DebugContext(ctx, "api key is revoked or expired", slog.Uint64("key_id", key.ID))
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_INVALID_KEY)
+	}
+
+	slog.DebugContext(ctx, "authenticated with api key", slog.Uint64("key_id", key.ID))
+
+	id := strconv.FormatUint(key.ID, 10)
+	return authn.NewContext(ctx, authn.Principal{
+		Subject:  "api-key:" + id,
+		KeyID:    id,
+		TenantID: key.TenantID,
+		Roles:    key.Roles,
+	}), nil
+}
+
+// lookup - Returns the key with the digest from the cache or the store, only keys that exist are cached
+func (a *KeyAuthn) lookup(ctx context.Context, hash string) (storage.ApiKey, error) {
+	if key, ok := a.cache.Get(hash); ok {
+		return key, nil
+	}
+
+	a.mu.Lock()
+	generation := a.generation
+	a.mu.Unlock()
+
+	key, err := a.store.Lookup(ctx, hash)
+	if err != nil {
+		if apierrors.FromError(err).Code == base.ErrorCode_ERROR_CODE_NOT_FOUND {
+			return storage.ApiKey{}, apierrors.New(base.ErrorCode_ERROR_CODE_INVALID_KEY)
+		}
+		slog.ErrorContext(ctx, "failed to look up api key", slog.Any("error", err))
+		return storage.ApiKey{}, apierrors.New(base.ErrorCode_ERROR_CODE_INTERNAL)
+	}
+
+	// The key may have been revoked while it was read, caching it then would let it pass until the TTL ends.
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.generation == generation {
+		a.cache.SetWithTTL(hash, key, 1, a.ttl)
+	}
+	return key, nil
+}
+
+// Forget - Evicts the key with the digest, so it is looked up again on its next use. Lookups in flight
+// meanwhile do not cache the key they read.
+func (a *KeyAuthn) Forget(hash string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.generation++
+	a.cache.Del(hash)
+}
+
+// Wait - Blocks until buffered cache sets are applied
+func (a *KeyAuthn) Wait() {
+	a.cache.Wait()
+}
+
+// Close - Stops the cache
+func (a *KeyAuthn) Close() {
+	a.cache.Close()
+}
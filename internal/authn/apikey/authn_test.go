@@ -0,0 +1,203 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	memoryStorage "github.com/tolgaOzen/go-skeleton/internal/storage/memory"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+func TestApiKeyAuth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "authentication api key suite")
+}
+
+// fallbackAuthn accepts every bearer token it is given
+type fallbackAuthn struct{}
+
+func (fallbackAuthn) Authenticate(ctx context.Context) (context.Context, error) {
+	return authn.NewContext(ctx, authn.Principal{Subject: "fallback"}), nil
+}
+
+// countingStore counts the lookups reaching the store, afterLookup runs once a key was read when set
+type countingStore struct {
+	storage.ApiKeyStore
+	lookups     int
+	afterLookup func()
+}
+
+func (s *countingStore) Lookup(ctx context.Context, hash string) (storage.ApiKey, error) {
+	s.lookups++
+	key, err := s.ApiKeyStore.Lookup(ctx, hash)
+	if s.afterLookup != nil {
+		s.afterLookup()
+	}
+	return key, err
+}
+
+func withToken(token string) context.Context {
+	md := metadata.New(map[string]string{"authorization": "Bearer " + token})
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+var _ = Describe("KeyAuthn", func() {
+	var (
+		db            *memory.Memory
+		store         *countingStore
+		authenticator *KeyAuthn
+		now           time.Time
+	)
+
+	mint := func(ctx context.Context, key storage.ApiKey) (string, *base.ApiKey) {
+		secret, err := NewSecret()
+		Expect(err).ToNot(HaveOccurred())
+		key.Prefix, key.Hash = secret.Prefix, secret.Hash
+		created, err := store.Create(ctx, key)
+		Expect(err).ToNot(HaveOccurred())
+		return secret.Value, created
+	}
+
+	BeforeEach(func() {
+		var err error
+		db, err = memory.New(memoryStorage.Schema)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(memoryStorage.Seed(db)).To(Succeed())
+
+		now = time.Now()
+		store = &countingStore{ApiKeyStore: memoryStorage.NewApiKeyStore(db)}
+		authenticator, err = NewKeyAuthn(store, config.ApiKey{CacheTTL: time.Minute, CacheEntries: 100}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		authenticator.now = func() time.Time { return now }
+	})
+
+	AfterEach(func() {
+		authenticator.Close()
+		Expect(db.Close()).To(Succeed())
+	})
+
+	Describe("NewSecret", func() {
+		It("should generate distinct secrets recognizable by their prefix", func() {
+			first, err := NewSecret()
+			Expect(err).ToNot(HaveOccurred())
+			second, err := NewSecret()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(first.Value).ToNot(Equal(second.Value))
+			Expect(IsSecret(first.Value)).To(BeTrue())
+			Expect(first.Value).To(HavePrefix(first.Prefix))
+			Expect(first.Hash).To(Equal(Hash(first.Value)))
+			Expect(first.Hash).To(HaveLen(64))
+		})
+	})
+
+	Describe("Authenticate", func() {
+		It("should inject the key id, tenant and roles", func() {
+			secret, created := mint(tenancy.NewContext(context.Background(), 2), storage.ApiKey{Name: "ci", Roles: []string{"viewer"}})
+
+			out, err := authenticator.Authenticate(withToken(secret))
+			Expect(err).ToNot(HaveOccurred())
+
+			principal, ok := authn.FromContext(out)
+			Expect(ok).To(BeTrue())
+			Expect(principal.Subject).To(Equal("api-key:1"))
+			Expect(principal.KeyID).To(Equal("1"))
+			Expect(principal.TenantID).To(Equal(created.GetTenantId()))
+			Expect(principal.Roles).To(Equal([]string{"viewer"}))
+		})
+
+		It("should only look a key up once while it is cached", func() {
+			secret, _ := mint(context.Background(), storage.ApiKey{Name: "ci"})
+
+			for range 3 {
+				_, err := authenticator.Authenticate(withToken(secret))
+				Expect(err).ToNot(HaveOccurred())
+				authenticator.Wait()
+			}
+			Expect(store.lookups).To(Equal(1))
+		})
+
+		It("should reject revoked keys", func() {
+			secret, created := mint(context.Background(), storage.ApiKey{Name: "ci"})
+
+			_, err := store.Revoke(context.Background(), created.GetId())
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = authenticator.Authenticate(withToken(secret))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should not cache a key revoked while it was looked up", func() {
+			secret, created := mint(context.Background(), storage.ApiKey{Name: "ci"})
+
+			// the key is revoked and forgotten after the lookup read it, before it is cached
+			store.afterLookup = func() {
+				store.afterLookup = nil
+				_, err := store.Revoke(context.Background(), created.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				authenticator.Forget(Hash(secret))
+			}
+
+			_, err := authenticator.Authenticate(withToken(secret))
+			Expect(err).ToNot(HaveOccurred())
+			authenticator.Wait()
+
+			_, err = authenticator.Authenticate(withToken(secret))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+			Expect(store.lookups).To(Equal(2))
+		})
+
+		It("should reject expired keys", func() {
+			secret, _ := mint(context.Background(), storage.ApiKey{Name: "ci", ExpiresAt: now.Add(time.Hour)})
+
+			_, err := authenticator.Authenticate(withToken(secret))
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(2 * time.Hour)
+			_, err = authenticator.Authenticate(withToken(secret))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should reject unknown secrets and other tokens", func() {
+			_, err := authenticator.Authenticate(withToken(SecretPrefix + "unknown"))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+			_, err = authenticator.Authenticate(withToken("secret"))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+			Expect(store.lookups).To(Equal(1))
+		})
+
+		It("should pass other tokens to the fallback", func() {
+			withFallback, err := NewKeyAuthn(store, config.ApiKey{CacheTTL: time.Minute, CacheEntries: 100}, fallbackAuthn{})
+			Expect(err).ToNot(HaveOccurred())
+			defer withFallback.Close()
+
+			out, err := withFallback.Authenticate(withToken("secret"))
+			Expect(err).ToNot(HaveOccurred())
+			principal, _ := authn.FromContext(out)
+			Expect(principal.Subject).To(Equal("fallback"))
+
+			_, err = withFallback.Authenticate(withToken(SecretPrefix + "unknown"))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should return an error without a bearer token", func() {
+			_, err := authenticator.Authenticate(context.Background())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN.String()))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// SecretPrefix starts every secret, so keys are recognizable and other bearer tokens are rejected
+	// without a lookup.
+	SecretPrefix = "sk_"
+
+	// secretBytes is the number of random bytes of a secret.
+	secretBytes = 32
+
+	// displayLength is the number of leading characters of a secret kept to recognize its key by.
+	displayLength = 10
+)
+
+// Secret - A freshly generated secret with the parts of it that are stored
+type Secret struct {
+	Value  string // the secret itself, shown once and never stored
+	Prefix string // leading characters of the secret
+	Hash   string // hex encoded SHA-256 digest of the secret
+}
+
+// NewSecret - Generates a random secret
+func NewSecret() (Secret, error) {
+	random := make([]byte, secretBytes)
+	if _, err := rand.Read(random); err != nil {
+		return Secret{}, err
+	}
+	value := SecretPrefix + base64.RawURLEncoding.EncodeToString(random)
+	return Secret{
+		Value:  value,
+		Prefix: value[:displayLength],
+		Hash:   Hash(value),
+	}, nil
+}
+
+// Hash - Returns the hex encoded SHA-256 digest keys are stored and looked up by. Secrets are random enough
+// that a fast hash does not make them guessable.
+func Hash(secret string) string {
+	digest := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(digest[:])
+}
+
+// IsSecret - Whether the bearer token looks like a secret of an API key
+func IsSecret(token string) bool {
+	return strings.HasPrefix(token, SecretPrefix)
+}
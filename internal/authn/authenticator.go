@@ -4,7 +4,8 @@ import (
 	"context"
 )
 
-// Authenticator - Interface for oidc authenticator
+// Authenticator - Interface for authenticators
+// Authenticate returns a context carrying the authenticated Principal, if the method yields one.
 type Authenticator interface {
-	Authenticate(ctx context.Context) error
+	Authenticate(ctx context.Context) (context.Context, error)
 }
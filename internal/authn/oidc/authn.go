@@ -0,0 +1,113 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// Authn - Authenticates JWT bearer tokens against the signing keys of an OIDC issuer
+type Authn struct {
+	keys       *keySet
+	parser     *jwt.Parser
+	rolesClaim string
+}
+
+// NewOidcAuthn - Creates new OIDC authenticator, the signing keys are loaded from the local JWKS file
+// when one is configured, otherwise they are discovered from the issuer. Keys are refreshed in the
+// background until the context is canceled.
+func NewOidcAuthn(ctx context.Context, cfg config.Oidc) (*Authn, error) {
+	var source keySource
+	switch {
+	case cfg.JWKSFile != "":
+		source = fileSource(cfg.JWKSFile)
+	case cfg.Issuer != "":
+		source = discoverySource(&http.Client{Timeout: 10 * time.Second}, cfg.Issuer)
+	default:
+		return nil, errors.New("oidc authn requires an issuer or a jwks file")
+	}
+
+	if len(cfg.ValidMethods) == 0 {
+		return nil, errors.New("oidc authn must have at least one valid method")
+	}
+
+	keys, err := newKeySet(ctx, source, cfg.BackoffInterval, cfg.BackoffFrequency, cfg.BackoffMaxRetries)
+	if err != nil {
+		return nil, err
+	}
+	go keys.run(ctx, cfg.RefreshInterval)
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(cfg.ValidMethods),
+		jwt.WithLeeway(cfg.Leeway),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	return &Authn{
+		keys:       keys,
+		parser:     jwt.NewParser(opts...),
+		rolesClaim: cfg.RolesClaim,
+	}, nil
+}
+
+// Authenticate - Validates the bearer token and injects the verified subject, claims and roles into the context
+func (a *Authn) Authenticate(ctx context.Context) (context.Context, error) {
+	raw, err := grpcAuth.AuthFromMD(ctx, "Bearer")
+	if err != nil {
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN)
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = a.parser.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return a.keys.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_INVALID_BEARER_TOKEN)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_INVALID_BEARER_TOKEN)
+	}
+
+	return authn.NewContext(ctx, authn.Principal{
+		Subject: subject,
+		Claims:  claims,
+		Roles:   roles(claims[a.rolesClaim]),
+	}), nil
+}
+
+// roles reads the roles claim, which is either a list of roles or a space separated string like the scope claim
+func roles(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		roles := make([]string, 0, len(v))
+		for _, role := range v {
+			if r, ok := role.(string); ok && r != "" {
+				roles = append(roles, r)
+			}
+		}
+		return roles
+	}
+	return nil
+}
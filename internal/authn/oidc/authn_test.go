@@ -0,0 +1,180 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+func TestOidcAuthn(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "authentication oidc suite")
+}
+
+func writeJWKS(path string, keys map[string]*rsa.PrivateKey) {
+	set := jose.JSONWebKeySet{}
+	for kid, key := range keys {
+		set.Keys = append(set.Keys, jose.JSONWebKey{Key: &key.PublicKey, KeyID: kid, Algorithm: "RS256", Use: "sig"})
+	}
+	raw, err := json.Marshal(set)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(os.WriteFile(path, raw, 0o600)).Should(Succeed())
+}
+
+func sign(key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	raw, err := token.SignedString(key)
+	Expect(err).ShouldNot(HaveOccurred())
+	return raw
+}
+
+func withBearer(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+var _ = Describe("Authn", func() {
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		key           *rsa.PrivateKey
+		jwksPath      string
+		authenticator *Authn
+	)
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss": "https://issuer.example.com",
+			"aud": "skeleton",
+			"sub": "user-1",
+			"iat": time.Now().Unix(),
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}
+	}
+
+	expectUnauthenticated := func(err error) {
+		Expect(err).Should(HaveOccurred())
+		st, ok := status.FromError(err)
+		Expect(ok).Should(BeTrue())
+		Expect(st.Code()).Should(Equal(codes.Unauthenticated))
+		Expect(st.Message()).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_BEARER_TOKEN.String()))
+	}
+
+	BeforeEach(func() {
+		var err error
+		ctx, cancel = context.WithCancel(context.Background())
+
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		jwksPath = filepath.Join(GinkgoT().TempDir(), "jwks.json")
+		writeJWKS(jwksPath, map[string]*rsa.PrivateKey{"key-1": key})
+
+		authenticator, err = NewOidcAuthn(ctx, config.Oidc{
+			Issuer:            "https://issuer.example.com",
+			Audience:          "skeleton",
+			JWKSFile:          jwksPath,
+			RefreshInterval:   time.Hour,
+			BackoffInterval:   0,
+			BackoffFrequency:  time.Millisecond,
+			BackoffMaxRetries: 1,
+			Leeway:            time.Second,
+			ValidMethods:      []string{"RS256"},
+			RolesClaim:        "roles",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("Authenticate", func() {
+		It("should inject the principal for a valid token", func() {
+			out, err := authenticator.Authenticate(withBearer(sign(key, "key-1", validClaims())))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			principal, ok := authn.FromContext(out)
+			Expect(ok).Should(BeTrue())
+			Expect(principal.Subject).Should(Equal("user-1"))
+			Expect(principal.Claims["aud"]).Should(Equal("skeleton"))
+		})
+
+		It("should read the roles from a list or a space separated string", func() {
+			claims := validClaims()
+			claims["roles"] = []string{"reader", "writer"}
+			out, err := authenticator.Authenticate(withBearer(sign(key, "key-1", claims)))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			principal, _ := authn.FromContext(out)
+			Expect(principal.Roles).Should(Equal([]string{"reader", "writer"}))
+
+			claims["roles"] = "reader  admin"
+			out, err = authenticator.Authenticate(withBearer(sign(key, "key-1", claims)))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			principal, _ = authn.FromContext(out)
+			Expect(principal.Roles).Should(Equal([]string{"reader", "admin"}))
+		})
+
+		It("should fail when the token is missing", func() {
+			_, err := authenticator.Authenticate(context.Background())
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN.String()))
+		})
+
+		It("should fail for an expired token", func() {
+			claims := validClaims()
+			claims["exp"] = time.Now().Add(-time.Minute).Unix()
+			_, err := authenticator.Authenticate(withBearer(sign(key, "key-1", claims)))
+			expectUnauthenticated(err)
+		})
+
+		It("should fail for a wrong audience", func() {
+			claims := validClaims()
+			claims["aud"] = "other"
+			_, err := authenticator.Authenticate(withBearer(sign(key, "key-1", claims)))
+			expectUnauthenticated(err)
+		})
+
+		It("should fail for a wrong issuer", func() {
+			claims := validClaims()
+			claims["iss"] = "https://evil.example.com"
+			_, err := authenticator.Authenticate(withBearer(sign(key, "key-1", claims)))
+			expectUnauthenticated(err)
+		})
+
+		It("should fail for a token signed by an unknown key", func() {
+			other, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = authenticator.Authenticate(withBearer(sign(other, "key-1", validClaims())))
+			expectUnauthenticated(err)
+		})
+
+		It("should pick up rotated keys", func() {
+			rotated, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).ShouldNot(HaveOccurred())
+			writeJWKS(jwksPath, map[string]*rsa.PrivateKey{"key-1": key, "key-2": rotated})
+
+			_, err = authenticator.Authenticate(withBearer(sign(rotated, "key-2", validClaims())))
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,208 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+// keySource returns the raw JSON Web Key Set of the issuer.
+type keySource func(ctx context.Context) ([]byte, error)
+
+// fileSource reads the key set from a local file, replacing the file rotates the keys.
+func fileSource(path string) keySource {
+	return func(_ context.Context) ([]byte, error) {
+		return os.ReadFile(path)
+	}
+}
+
+// discoverySource fetches the key set from the jwks_uri advertised by the issuer's discovery document.
+func discoverySource(client *http.Client, issuer string) keySource {
+	var (
+		mu      sync.Mutex
+		jwksURI string
+	)
+	return func(ctx context.Context) ([]byte, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if jwksURI == "" {
+			var doc struct {
+				JWKSURI string `json:"jwks_uri"`
+			}
+			body, err := get(ctx, client, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration")
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch discovery document: %w", err)
+			}
+			if err = json.Unmarshal(body, &doc); err != nil {
+				return nil, fmt.Errorf("failed to decode discovery document: %w", err)
+			}
+			if doc.JWKSURI == "" {
+				return nil, errors.New("discovery document has no jwks_uri")
+			}
+			jwksURI = doc.JWKSURI
+		}
+		return get(ctx, client, jwksURI)
+	}
+}
+
+// get performs a GET request and returns the response body.
+func get(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+// keySet caches the signing keys of the issuer by key id and refreshes them when they rotate.
+type keySet struct {
+	source keySource
+
+	backoffInterval   time.Duration
+	backoffFrequency  time.Duration
+	backoffMaxRetries int
+
+	mu        sync.RWMutex
+	keys      map[string]interface{}
+	lastFetch time.Time
+}
+
+// newKeySet creates a key set and loads the initial keys, retrying failed fetches.
+func newKeySet(ctx context.Context, source keySource, backoffInterval, backoffFrequency time.Duration, backoffMaxRetries int) (*keySet, error) {
+	ks := &keySet{
+		source:            source,
+		backoffInterval:   backoffInterval,
+		backoffFrequency:  backoffFrequency,
+		backoffMaxRetries: backoffMaxRetries,
+		keys:              map[string]interface{}{},
+	}
+
+	var err error
+	for attempt := 0; attempt <= backoffMaxRetries; attempt++ {
+		if err = ks.refresh(ctx); err == nil {
+			return ks, nil
+		}
+		slog.Warn("failed to fetch jwks", slog.Int("attempt", attempt+1), slog.Any("error", err))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoffFrequency):
+		}
+	}
+	return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+}
+
+// run refreshes the keys every interval until the context is canceled.
+func (ks *keySet) run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := ks.refresh(ctx); err != nil {
+				slog.Error("failed to refresh jwks", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// refresh fetches the key set and replaces the cached keys.
+func (ks *keySet) refresh(ctx context.Context) error {
+	raw, err := ks.source(ctx)
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.lastFetch = time.Now()
+
+	if err != nil {
+		return err
+	}
+
+	var set jose.JSONWebKeySet
+	if err = json.Unmarshal(raw, &set); err != nil {
+		return fmt.Errorf("failed to decode jwks: %w", err)
+	}
+
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use == "enc" {
+			continue
+		}
+		// Never keep private material around, only what is needed to verify.
+		if !k.IsPublic() {
+			if public := k.Public(); public.Valid() {
+				k = public
+			}
+		}
+		keys[k.KeyID] = k.Key
+	}
+
+	if len(keys) == 0 {
+		return errors.New("jwks contains no signing keys")
+	}
+
+	ks.keys = keys
+	return nil
+}
+
+// key returns the key with the given id. Unknown ids trigger a refresh, at most once per backoff interval,
+// so keys rotated by the issuer are picked up without waiting for the periodic refresh.
+func (ks *keySet) key(ctx context.Context, kid string) (interface{}, error) {
+	if k, ok := ks.lookup(kid); ok {
+		return k, nil
+	}
+
+	ks.mu.RLock()
+	stale := time.Since(ks.lastFetch) >= ks.backoffInterval
+	ks.mu.RUnlock()
+
+	if stale {
+		if err := ks.refresh(ctx); err != nil {
+			slog.Error("failed to refresh jwks", slog.Any("error", err))
+		}
+		if k, ok := ks.lookup(kid); ok {
+			return k, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown key id '%s'", kid)
+}
+
+// lookup returns the cached key with the given id, a token without key id
+// is accepted only when the set holds a single key.
+func (ks *keySet) lookup(kid string) (interface{}, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	if kid == "" && len(ks.keys) == 1 {
+		for _, k := range ks.keys {
+			return k, true
+		}
+	}
+
+	k, ok := ks.keys[kid]
+	return k, ok
+}
@@ -2,45 +2,188 @@ package preshared
 
 import (
 	"context"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
 
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
 	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
 
 	"github.com/tolgaOzen/go-skeleton/internal/config"
 )
 
+// sha256Prefix marks hashes that are the hex encoded sha256 digest of the key
+const sha256Prefix = "sha256:"
+
 // KeyAuthn - Authentication Keys Structure
 type KeyAuthn struct {
-	keys map[string]struct{}
+	keys atomic.Pointer[keySet]
+	now  func() time.Time
+}
+
+// key - A preshared key with its decoded sha256 digest, digest is nil for bcrypt hashes
+type key struct {
+	config.PresharedKey
+	digest []byte
+}
+
+// keySet - The keys accepted at a time, replaced as a whole on reload
+type keySet struct {
+	keys []key
+	// hashed indexes the keys with a bcrypt hash by their id, the only one checked for a token
+	hashed map[string]int
+	// verified caches the sha256 digest of keys that matched a bcrypt hash, so bcrypt only runs once per key
+	verified sync.Map
 }
 
 // NewKeyAuthn - Create New Authenticated Keys
 func NewKeyAuthn(_ context.Context, cfg config.Preshared) (*KeyAuthn, error) {
-	if len(cfg.Keys) < 1 {
+	a := &KeyAuthn{now: time.Now}
+	if err := a.Reload(cfg); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Reload - Replaces the accepted keys, the current keys stay in use when the configuration is invalid
+func (a *KeyAuthn) Reload(cfg config.Preshared) error {
+	set, err := newKeySet(cfg)
+	if err != nil {
+		return err
+	}
+	a.keys.Store(set)
+	return nil
+}
+
+// newKeySet - Validates the configured keys
+func newKeySet(cfg config.Preshared) (*keySet, error) {
+	if len(cfg.Keys)+len(cfg.RawKeys) < 1 {
 		return nil, errors.New("pre shared key authn must have at least one key")
 	}
-	mapKeys := make(map[string]struct{})
+
+	ids := make(map[string]struct{}, len(cfg.Keys)+len(cfg.RawKeys))
+	set := &keySet{keys: make([]key, 0, len(cfg.Keys)+len(cfg.RawKeys)), hashed: map[string]int{}}
+
+	for _, raw := range cfg.RawKeys {
+		if raw == "" {
+			return nil, errors.New("pre shared keys can not be empty")
+		}
+		digest := sha256.Sum256([]byte(raw))
+		k := config.PlaintextKey(raw)
+		if _, found := ids[k.ID]; found {
+			return nil, fmt.Errorf("pre shared key '%s' is listed more than once", k.ID)
+		}
+		ids[k.ID] = struct{}{}
+		set.keys = append(set.keys, key{PresharedKey: k, digest: digest[:]})
+	}
+
 	for _, k := range cfg.Keys {
-		mapKeys[k] = struct{}{}
+		if k.ID == "" {
+			return nil, errors.New("pre shared keys must have an id")
+		}
+		if _, found := ids[k.ID]; found {
+			return nil, fmt.Errorf("pre shared key id '%s' is not unique", k.ID)
+		}
+		ids[k.ID] = struct{}{}
+
+		if !k.NotBefore.IsZero() && !k.ExpiresAt.IsZero() && !k.ExpiresAt.After(k.NotBefore) {
+			return nil, fmt.Errorf("pre shared key '%s' expires before it becomes valid", k.ID)
+		}
+
+		parsed := key{PresharedKey: k}
+		switch {
+		case strings.HasPrefix(k.Hash, sha256Prefix):
+			digest, err := hex.DecodeString(strings.TrimPrefix(k.Hash, sha256Prefix))
+			if err != nil || len(digest) != sha256.Size {
+				return nil, fmt.Errorf("pre shared key '%s' has an invalid sha256 hash", k.ID)
+			}
+			parsed.digest = digest
+		case strings.HasPrefix(k.Hash, "$2"):
+			if _, err := bcrypt.Cost([]byte(k.Hash)); err != nil {
+				return nil, fmt.Errorf("pre shared key '%s' has an invalid bcrypt hash: %w", k.ID, err)
+			}
+			if strings.Contains(k.ID, ".") {
+				return nil, fmt.Errorf("pre shared key '%s' has a bcrypt hash, its id can not contain a dot", k.ID)
+			}
+			set.hashed[k.ID] = len(set.keys)
+		default:
+			return nil, fmt.Errorf("pre shared key '%s' must have a sha256 or bcrypt hash", k.ID)
+		}
+		set.keys = append(set.keys, parsed)
 	}
-	return &KeyAuthn{
-		keys: mapKeys,
-	}, nil
+	return set, nil
 }
 
-// Authenticate - Checking whether any API request contain keys
-func (a *KeyAuthn) Authenticate(ctx context.Context) error {
-	key, err := grpcAuth.AuthFromMD(ctx, "Bearer")
+// match - Finds the key the raw key hashes to. Digests are compared in constant time against every key
+// so the position of the matching key is not leaked. Keys with a bcrypt hash are presented as <id>.<key>,
+// so a token costs at most one bcrypt comparison however many keys are configured
+func (s *keySet) match(raw string) (key, bool) {
+	digest := sha256.Sum256([]byte(raw))
+
+	found := -1
+	for i, k := range s.keys {
+		if k.digest != nil && subtle.ConstantTimeCompare(k.digest, digest[:]) == 1 {
+			found = i
+		}
+	}
+	if found >= 0 {
+		return s.keys[found], true
+	}
+
+	if i, ok := s.verified.Load(string(digest[:])); ok {
+		return s.keys[i.(int)], true
+	}
+	id, secret, ok := strings.Cut(raw, ".")
+	if !ok {
+		return key{}, false
+	}
+	i, ok := s.hashed[id]
+	if !ok || bcrypt.CompareHashAndPassword([]byte(s.keys[i].Hash), []byte(secret)) != nil {
+		return key{}, false
+	}
+	s.verified.Store(string(digest[:]), i)
+	return s.keys[i], true
+}
+
+// Authenticate - Checking whether any API request contain keys, the id, labels and roles of the key are injected into the context
+func (a *KeyAuthn) Authenticate(ctx context.Context) (context.Context, error) {
+	raw, err := grpcAuth.AuthFromMD(ctx, "Bearer")
 	if err != nil {
-		return errors.New(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN.String())
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN)
+	}
+
+	k, found := a.keys.Load().match(raw)
+	if !found {
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_INVALID_KEY)
 	}
-	if _, found := a.keys[key]; found {
-		return nil
+
+	now := a.now()
+	if (!k.NotBefore.IsZero() && now.Before(k.NotBefore)) || (!k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)) {
+		slog.DebugContext(ctx, "preshared key is not valid at this time", slog.String("key_id", k.ID))
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_INVALID_KEY)
+	}
+
+	slog.DebugContext(ctx, "authenticated with preshared key", slog.String("key_id", k.ID))
+
+	claims := make(map[string]interface{}, len(k.Labels))
+	for name, value := range k.Labels {
+		claims[name] = value
 	}
-	return status.Error(codes.Unauthenticated, base.ErrorCode_ERROR_CODE_INVALID_KEY.String())
+	return authn.NewContext(ctx, authn.Principal{
+		Subject: k.ID,
+		KeyID:   k.ID,
+		Claims:  claims,
+		Roles:   k.Roles,
+	}), nil
 }
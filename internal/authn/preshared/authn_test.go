@@ -2,15 +2,22 @@ package preshared
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
 	"testing"
+	"time"
 
+	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
 
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
 	"github.com/tolgaOzen/go-skeleton/internal/config"
 	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
 )
@@ -20,41 +27,183 @@ func TestPresharedKeyAuth(t *testing.T) {
 	RunSpecs(t, "authentication preshared key suite")
 }
 
+func sha256Hash(key string) string {
+	digest := sha256.Sum256([]byte(key))
+	return "sha256:" + hex.EncodeToString(digest[:])
+}
+
+func bcryptHash(key string) string {
+	hash, err := bcrypt.GenerateFromPassword([]byte(key), bcrypt.MinCost)
+	Expect(err).ToNot(HaveOccurred())
+	return string(hash)
+}
+
+func withKey(key string) context.Context {
+	md := metadata.New(map[string]string{"authorization": "Bearer " + key})
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
 var _ = Describe("KeyAuthn", func() {
 	var (
 		ctx           context.Context
 		authenticator *KeyAuthn
 		err           error
 		keysConfig    config.Preshared
+		now           time.Time
 	)
 
 	BeforeEach(func() {
-		keysConfig = config.Preshared{Keys: []string{"key1", "key2"}}
+		now = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		keysConfig = config.Preshared{
+			Keys: []config.PresharedKey{
+				{ID: "ci", Hash: sha256Hash("key1"), Labels: map[string]string{"tenant_id": "2"}, Roles: []string{"admin"}},
+				{ID: "ops", Hash: bcryptHash("key2")},
+				{ID: "old", Hash: sha256Hash("key3"), ExpiresAt: now.Add(-time.Hour)},
+				{ID: "next", Hash: sha256Hash("key4"), NotBefore: now.Add(time.Hour)},
+			},
+		}
 		authenticator, err = NewKeyAuthn(context.Background(), keysConfig)
 		Expect(err).ToNot(HaveOccurred())
+		authenticator.now = func() time.Time { return now }
+	})
+
+	Describe("NewKeyAuthn", func() {
+		It("should reject keys without an id", func() {
+			_, err := NewKeyAuthn(context.Background(), config.Preshared{
+				Keys: []config.PresharedKey{{Hash: sha256Hash("key1")}},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject duplicate ids", func() {
+			_, err := NewKeyAuthn(context.Background(), config.Preshared{
+				Keys: []config.PresharedKey{{ID: "ci", Hash: sha256Hash("key1")}, {ID: "ci", Hash: sha256Hash("key2")}},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject plaintext and malformed hashes", func() {
+			for _, hash := range []string{"key1", "sha256:abc", "$2a$invalid"} {
+				_, err := NewKeyAuthn(context.Background(), config.Preshared{
+					Keys: []config.PresharedKey{{ID: "ci", Hash: hash}},
+				})
+				Expect(err).To(HaveOccurred(), hash)
+			}
+		})
+
+		It("should hash raw keys and identify them by their digest", func() {
+			withRaw, err := NewKeyAuthn(context.Background(), config.Preshared{RawKeys: []string{"key6"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			out, err := withRaw.Authenticate(withKey("key6"))
+			Expect(err).ToNot(HaveOccurred())
+			principal, _ := authn.FromContext(out)
+			Expect(principal.KeyID).To(Equal("key-" + strings.TrimPrefix(sha256Hash("key6"), "sha256:")[:8]))
+
+			_, err = NewKeyAuthn(context.Background(), config.Preshared{RawKeys: []string{"key6", "key6"}})
+			Expect(err).To(HaveOccurred())
+			_, err = NewKeyAuthn(context.Background(), config.Preshared{RawKeys: []string{""}})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should accept keys listed in plaintext in config files", func() {
+			v := viper.New()
+			v.SetConfigType("yaml")
+			err := v.ReadConfig(strings.NewReader("keys: [\"key7\", {id: ci, hash: \"" + sha256Hash("key8") + "\"}]"))
+			Expect(err).ToNot(HaveOccurred())
+
+			var cfg config.Preshared
+			Expect(v.Unmarshal(&cfg, config.DecodeHook())).To(Succeed())
+			Expect(cfg.Keys).To(HaveLen(2))
+
+			legacy, err := NewKeyAuthn(context.Background(), cfg)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = legacy.Authenticate(withKey("key7"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = legacy.Authenticate(withKey("key8"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(v.ReadConfig(strings.NewReader(`keys: [""]`))).To(Succeed())
+			Expect(v.Unmarshal(&cfg, config.DecodeHook())).ToNot(Succeed())
+		})
+
+		It("should reject ids containing a dot for bcrypt hashes", func() {
+			_, err := NewKeyAuthn(context.Background(), config.Preshared{
+				Keys: []config.PresharedKey{{ID: "ops.eu", Hash: bcryptHash("key2")}},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject keys that expire before they become valid", func() {
+			_, err := NewKeyAuthn(context.Background(), config.Preshared{
+				Keys: []config.PresharedKey{{ID: "ci", Hash: sha256Hash("key1"), NotBefore: now, ExpiresAt: now}},
+			})
+			Expect(err).To(HaveOccurred())
+		})
 	})
 
 	Describe("Authenticate", func() {
-		Context("with valid Bearer token", func() {
-			BeforeEach(func() {
-				md := metadata.New(map[string]string{"authorization": "Bearer key1"})
-				ctx = metadata.NewIncomingContext(context.Background(), md)
+		Context("with a key matching a sha256 hash", func() {
+			It("should inject the key id, labels and roles", func() {
+				out, err := authenticator.Authenticate(withKey("key1"))
+				Expect(err).ToNot(HaveOccurred())
+
+				principal, ok := authn.FromContext(out)
+				Expect(ok).To(BeTrue())
+				Expect(principal.KeyID).To(Equal("ci"))
+				Expect(principal.Subject).To(Equal("ci"))
+				Expect(principal.Claims).To(HaveKeyWithValue("tenant_id", "2"))
+				Expect(principal.Roles).To(Equal([]string{"admin"}))
+			})
+		})
+
+		Context("with a key matching a bcrypt hash", func() {
+			It("should authenticate successfully, also once the match is cached", func() {
+				for range 2 {
+					out, err := authenticator.Authenticate(withKey("ops.key2"))
+					Expect(err).ToNot(HaveOccurred())
+
+					principal, ok := authn.FromContext(out)
+					Expect(ok).To(BeTrue())
+					Expect(principal.KeyID).To(Equal("ops"))
+				}
+			})
+		})
+
+		Context("with a bcrypt key not prefixed by its id", func() {
+			It("should return an error", func() {
+				for _, token := range []string{"key2", "ci.key2", "ops.key1"} {
+					_, err := authenticator.Authenticate(withKey(token))
+					Expect(status.Code(err)).To(Equal(codes.Unauthenticated), token)
+				}
+			})
+		})
+
+		Context("with an expired key", func() {
+			It("should return an error", func() {
+				_, err := authenticator.Authenticate(withKey("key3"))
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
 			})
+		})
 
-			It("should authenticate successfully", func() {
-				err := authenticator.Authenticate(ctx)
+		Context("with a key that is not valid yet", func() {
+			It("should return an error until it becomes valid", func() {
+				_, err := authenticator.Authenticate(withKey("key4"))
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+				now = now.Add(2 * time.Hour)
+				_, err = authenticator.Authenticate(withKey("key4"))
 				Expect(err).ToNot(HaveOccurred())
 			})
 		})
 
 		Context("with invalid Bearer token", func() {
 			BeforeEach(func() {
-				md := metadata.New(map[string]string{"authorization": "Bearer invalidkey"})
-				ctx = metadata.NewIncomingContext(context.Background(), md)
+				ctx = withKey("invalidkey")
 			})
 
 			It("should return an error", func() {
-				err := authenticator.Authenticate(ctx)
+				_, err := authenticator.Authenticate(ctx)
 				Expect(err).To(HaveOccurred())
 				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
 			})
@@ -66,10 +215,33 @@ var _ = Describe("KeyAuthn", func() {
 			})
 
 			It("should return an error", func() {
-				err := authenticator.Authenticate(ctx)
+				_, err := authenticator.Authenticate(ctx)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN.String()))
 			})
 		})
 	})
+
+	Describe("Reload", func() {
+		It("should rotate the accepted keys", func() {
+			Expect(authenticator.Reload(config.Preshared{
+				Keys: []config.PresharedKey{{ID: "ci-2", Hash: sha256Hash("key5")}},
+			})).To(Succeed())
+
+			_, err := authenticator.Authenticate(withKey("key1"))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+			out, err := authenticator.Authenticate(withKey("key5"))
+			Expect(err).ToNot(HaveOccurred())
+			principal, _ := authn.FromContext(out)
+			Expect(principal.KeyID).To(Equal("ci-2"))
+		})
+
+		It("should keep the current keys when the configuration is invalid", func() {
+			Expect(authenticator.Reload(config.Preshared{})).ToNot(Succeed())
+
+			_, err := authenticator.Authenticate(withKey("key1"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
 })
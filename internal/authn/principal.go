@@ -0,0 +1,32 @@
+package authn
+
+import (
+	"context"
+)
+
+// Principal - The identity of an authenticated caller
+type Principal struct {
+	// Subject identifies the caller, e.g. the "sub" claim of a token or the id of a preshared key
+	Subject string
+	// KeyID is the id of the preshared or API key the caller authenticated with, empty for tokens
+	KeyID string
+	// TenantID is the tenant the credentials are bound to, zero when they are not bound to one
+	TenantID uint64
+	// Claims holds the verified claims the caller was authenticated with
+	Claims map[string]interface{}
+	// Roles the caller was granted, the authorization policy maps them to permissions
+	Roles []string
+}
+
+type principalKey struct{}
+
+// NewContext - Returns a copy of the context carrying the principal
+func NewContext(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext - Returns the principal stored in the context, if any
+func FromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
@@ -0,0 +1,120 @@
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Policy - Grants permissions to roles, the methods listed override the permissions declared by the
+// permissions option of their rpc
+type Policy struct {
+	Roles   []Role              `mapstructure:"roles"`
+	Methods []MethodPermissions `mapstructure:"methods"`
+}
+
+// Role - Permissions granted to the callers having the role, "*" grants every permission and a
+// prefix such as "users.*" every permission starting with it
+type Role struct {
+	Name        string   `mapstructure:"name"`
+	Permissions []string `mapstructure:"permissions"`
+}
+
+// MethodPermissions - Permissions a caller needs to call the method
+type MethodPermissions struct {
+	Method      string   `mapstructure:"method"` // Full gRPC method name, e.g. /base.v1.UserService/Create
+	Permissions []string `mapstructure:"permissions"`
+}
+
+// LoadPolicy - Reads the policy file and creates an Authorizer enforcing it, the file can be written in any
+// format the configuration file can
+func LoadPolicy(path string) (*Authorizer, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	var policy Policy
+	if err := v.Unmarshal(&policy); err != nil {
+		return nil, fmt.Errorf("failed to decode policy file: %w", err)
+	}
+	return New(policy)
+}
+
+// Authorizer - Decides whether roles grant the permissions of a method
+type Authorizer struct {
+	grants  map[string][]string
+	methods map[string][]string
+}
+
+// New - Creates an Authorizer enforcing the policy
+func New(policy Policy) (*Authorizer, error) {
+	a := &Authorizer{
+		grants:  make(map[string][]string, len(policy.Roles)),
+		methods: make(map[string][]string, len(policy.Methods)),
+	}
+
+	for _, role := range policy.Roles {
+		if role.Name == "" {
+			return nil, errors.New("policy roles must have a name")
+		}
+		if _, found := a.grants[role.Name]; found {
+			return nil, fmt.Errorf("policy role %s is declared more than once", role.Name)
+		}
+		a.grants[role.Name] = role.Permissions
+	}
+
+	for _, method := range policy.Methods {
+		if !strings.HasPrefix(method.Method, "/") {
+			return nil, fmt.Errorf("policy method %q must be a full method name such as /base.v1.UserService/Create", method.Method)
+		}
+		a.methods[method.Method] = method.Permissions
+	}
+
+	return a, nil
+}
+
+// Override - Returns the permissions the policy requires for the method, if it lists it
+func (a *Authorizer) Override(fullMethod string) ([]string, bool) {
+	permissions, ok := a.methods[fullMethod]
+	return permissions, ok
+}
+
+// Missing - Returns the first of the permissions the roles do not grant, empty when they grant all of them
+func (a *Authorizer) Missing(roles []string, permissions []string) string {
+	for _, permission := range permissions {
+		if !a.granted(roles, permission) {
+			return permission
+		}
+	}
+	return ""
+}
+
+// Exceeding - Returns the first permission of the granted roles the roles do not grant, empty when granting
+// them hands out no more than the roles already have
+func (a *Authorizer) Exceeding(roles []string, granted []string) string {
+	for _, role := range granted {
+		if missing := a.Missing(roles, a.grants[role]); missing != "" {
+			return missing
+		}
+	}
+	return ""
+}
+
+// granted reports whether one of the roles grants the permission
+func (a *Authorizer) granted(roles []string, permission string) bool {
+	for _, role := range roles {
+		for _, grant := range a.grants[role] {
+			if grant == permission {
+				return true
+			}
+			if prefix, ok := strings.CutSuffix(grant, "*"); ok && strings.HasPrefix(permission, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package authz
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizer(t *testing.T) {
+	a, err := New(Policy{
+		Roles: []Role{
+			{Name: "admin", Permissions: []string{"*"}},
+			{Name: "editor", Permissions: []string{"users.*"}},
+			{Name: "viewer", Permissions: []string{"users.read"}},
+		},
+		Methods: []MethodPermissions{
+			{Method: "/base.v1.UserService/Delete", Permissions: []string{"users.write", "users.delete"}},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Empty(t, a.Missing([]string{"admin"}, []string{"tenants.write"}))
+	assert.Empty(t, a.Missing([]string{"editor"}, []string{"users.write", "users.delete"}))
+	assert.Empty(t, a.Missing([]string{"viewer", "editor"}, []string{"users.read"}))
+	assert.Equal(t, "users.write", a.Missing([]string{"viewer"}, []string{"users.read", "users.write"}))
+	assert.Equal(t, "audit.read", a.Missing([]string{"editor"}, []string{"audit.read"}))
+	assert.Equal(t, "users.read", a.Missing([]string{"unknown"}, []string{"users.read"}))
+	assert.Equal(t, "users.read", a.Missing(nil, []string{"users.read"}))
+
+	assert.Empty(t, a.Exceeding([]string{"admin"}, []string{"editor", "viewer"}))
+	assert.Empty(t, a.Exceeding([]string{"editor"}, []string{"viewer", "unknown"}))
+	assert.Equal(t, "users.*", a.Exceeding([]string{"viewer"}, []string{"editor"}))
+	assert.Equal(t, "*", a.Exceeding([]string{"editor"}, []string{"admin"}))
+
+	permissions, ok := a.Override("/base.v1.UserService/Delete")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"users.write", "users.delete"}, permissions)
+
+	_, ok = a.Override("/base.v1.UserService/Get")
+	assert.False(t, ok)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Policy{Roles: []Role{{Name: "admin"}, {Name: "admin"}}})
+	assert.Error(t, err)
+
+	_, err = New(Policy{Roles: []Role{{Permissions: []string{"*"}}}})
+	assert.Error(t, err)
+
+	_, err = New(Policy{Methods: []MethodPermissions{{Method: "base.v1.UserService.Get"}}})
+	assert.Error(t, err)
+}
+
+func TestLoadPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+roles:
+  - name: viewer
+    permissions: ["users.read"]
+methods:
+  - method: /base.v1.UserService/Get
+    permissions: ["users.get"]
+`), 0o600))
+
+	a, err := LoadPolicy(path)
+	assert.NoError(t, err)
+	assert.Empty(t, a.Missing([]string{"viewer"}, []string{"users.read"}))
+
+	// method names keep their case
+	permissions, ok := a.Override("/base.v1.UserService/Get")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"users.get"}, permissions)
+
+	_, err = LoadPolicy(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
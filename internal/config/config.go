@@ -17,6 +17,7 @@ type (
 		Log      `mapstructure:"logger"`   // Logging configuration
 		Profiler `mapstructure:"profiler"` // Profiler configuration
 		Authn    `mapstructure:"authn"`    // Authentication configuration
+		Authz    `mapstructure:"authz"`    // Authorization configuration
 		Tracer   `mapstructure:"tracer"`   // Tracing configuration
 		Meter    `mapstructure:"meter"`    // Metrics configuration
 		Service  `mapstructure:"service"`  // Service configuration
@@ -25,10 +26,29 @@ type (
 
 	// Server contains the configurations for both HTTP and gRPC servers.
 	Server struct {
-		HTTP         `mapstructure:"http"` // HTTP server configuration
-		GRPC         `mapstructure:"grpc"` // gRPC server configuration
-		NameOverride string                `mapstructure:"name_override"`
-		RateLimit    int64                 `mapstructure:"rate_limit"` // Rate limit configuration
+		HTTP            `mapstructure:"http"` // HTTP server configuration
+		GRPC            `mapstructure:"grpc"` // gRPC server configuration
+		NameOverride    string                `mapstructure:"name_override"`
+		RateLimit       int64                 `mapstructure:"rate_limit"`        // Global rate limit shared by all clients
+		ClientRateLimit ClientRateLimit       `mapstructure:"client_rate_limit"` // Rate limit applied to every client separately
+	}
+
+	// ClientRateLimit contains configuration for the per client rate limiter.
+	ClientRateLimit struct {
+		Enabled        bool              `mapstructure:"enabled"`         // Whether clients are rate limited separately
+		Key            string            `mapstructure:"key"`             // Identity the buckets are keyed by: bearer, ip or subject
+		Rate           int64             `mapstructure:"rate"`            // Requests per second allowed for a client
+		Burst          int64             `mapstructure:"burst"`           // Maximum number of requests a client can burst
+		MaxClients     int               `mapstructure:"max_clients"`     // Number of buckets kept, the least recently used are evicted
+		TrustedProxies []string          `mapstructure:"trusted_proxies"` // Addresses or CIDR ranges of proxies whose x-forwarded-for is honoured
+		Methods        []MethodRateLimit `mapstructure:"methods"`         // Overrides for specific methods
+	}
+
+	// MethodRateLimit overrides the client rate limit for a gRPC method.
+	MethodRateLimit struct {
+		Method string `mapstructure:"method"` // Full gRPC method name, e.g. /base.v1.UserService/Create
+		Rate   int64  `mapstructure:"rate"`   // Requests per second allowed for a client
+		Burst  int64  `mapstructure:"burst"`  // Maximum number of requests a client can burst
 	}
 
 	// HTTP contains configuration for the HTTP server.
@@ -56,13 +76,52 @@ type (
 	// Authn contains configuration for authentication.
 	Authn struct {
 		Enabled   bool      `mapstructure:"enabled"`   // Whether authentication is enabled
-		Method    string    `mapstructure:"method"`    // The authentication method to be used
+		Method    string    `mapstructure:"method"`    // The authentication method to be used: preshared, oidc or apikey
 		Preshared Preshared `mapstructure:"preshared"` // Configuration for preshared key authentication
+		Oidc      Oidc      `mapstructure:"oidc"`      // Configuration for OIDC authentication
+		ApiKey    ApiKey    `mapstructure:"apikey"`    // Configuration for authentication with managed API keys
 	}
 
 	// Preshared contains configuration for preshared key authentication.
 	Preshared struct {
-		Keys []string `mapstructure:"keys"` // List of preshared keys
+		Keys    []PresharedKey `mapstructure:"keys"`     // Accepted keys, reloaded when the config file changes
+		RawKeys []string       `mapstructure:"raw_keys"` // Accepted keys in plaintext, e.g. from the environment, hashed when loaded
+	}
+
+	// PresharedKey contains a preshared key, stored as a hash of the key.
+	PresharedKey struct {
+		ID        string            `mapstructure:"id"`         // Identifies the key in logs and audit events
+		Hash      string            `mapstructure:"hash"`       // sha256:<hex digest> or a bcrypt hash of the key, sent as <id>.<key>
+		NotBefore time.Time         `mapstructure:"not_before"` // Time the key becomes valid, zero for immediately
+		ExpiresAt time.Time         `mapstructure:"expires_at"` // Time the key stops being valid, zero for never
+		Labels    map[string]string `mapstructure:"labels"`     // Free form labels, passed on as the claims of the caller
+		Roles     []string          `mapstructure:"roles"`      // Roles granted to the callers of the key
+	}
+
+	// Oidc contains configuration for OIDC/JWT bearer token authentication.
+	Oidc struct {
+		Issuer            string        `mapstructure:"issuer"`              // OIDC issuer URL, the JWKS is discovered from it
+		Audience          string        `mapstructure:"audience"`            // Expected audience of the tokens
+		JWKSFile          string        `mapstructure:"jwks_file"`           // Local JWKS file used instead of discovery, e.g. for offline setups
+		RefreshInterval   time.Duration `mapstructure:"refresh_interval"`    // Interval for refreshing the JWKS
+		BackoffInterval   time.Duration `mapstructure:"backoff_interval"`    // Minimum time between refreshes triggered by unknown key ids
+		BackoffFrequency  time.Duration `mapstructure:"backoff_frequency"`   // Delay between retries of a failed JWKS fetch
+		BackoffMaxRetries int           `mapstructure:"backoff_max_retries"` // Maximum number of retries of a failed JWKS fetch
+		Leeway            time.Duration `mapstructure:"leeway"`              // Allowed clock skew when validating time based claims
+		ValidMethods      []string      `mapstructure:"valid_methods"`       // Accepted signing algorithms
+		RolesClaim        string        `mapstructure:"roles_claim"`         // Claim holding the roles of the caller, a list or a space separated string
+	}
+
+	// ApiKey contains configuration for authentication with the API keys of the ApiKeyService.
+	ApiKey struct {
+		CacheTTL     time.Duration `mapstructure:"cache_ttl"`     // How long looked up keys are trusted, revocations take up to this long to apply
+		CacheEntries int64         `mapstructure:"cache_entries"` // Maximum number of cached keys
+	}
+
+	// Authz contains configuration for authorization.
+	Authz struct {
+		Enabled bool   `mapstructure:"enabled"` // Whether calls are authorized against the roles of the caller
+		Policy  string `mapstructure:"policy"`  // Path of the policy file granting permissions to roles
 	}
 
 	// Profiler contains configuration for the profiler.
@@ -102,7 +161,86 @@ type (
 
 	// Service contains configuration for various service-level features.
 	Service struct {
-		CircuitBreaker bool `mapstructure:"circuit_breaker"` // Whether to enable the circuit breaker pattern
+		CircuitBreaker bool        `mapstructure:"circuit_breaker"` // Whether to enable the circuit breaker pattern
+		Breaker        Breaker     `mapstructure:"breaker"`         // Circuit breaker settings
+		Cache          Cache       `mapstructure:"cache"`           // Read-through cache configuration
+		Idempotency    Idempotency `mapstructure:"idempotency"`     // Idempotency key configuration
+		Audit          Audit       `mapstructure:"audit"`           // Audit trail of mutating calls
+		Tenancy        Tenancy     `mapstructure:"tenancy"`         // Resolution of the tenant calls are made in
+		Purge          Purge       `mapstructure:"purge"`           // Purge of soft deleted users
+		Outbox         Outbox      `mapstructure:"outbox"`          // Relay of user events to other systems
+		Pagination     Pagination  `mapstructure:"pagination"`      // Pagination configuration
+		Health         Health      `mapstructure:"health"`          // Health check configuration
+	}
+
+	// Breaker contains the settings of the storage circuit breaker.
+	Breaker struct {
+		MinRequests  uint32        `mapstructure:"min_requests"`  // Minimum number of requests in an interval before the breaker can trip
+		FailureRatio float64       `mapstructure:"failure_ratio"` // Ratio of failed requests that trips the breaker
+		Interval     time.Duration `mapstructure:"interval"`      // Cyclic period after which the closed state counts are cleared, 0 never clears
+		Timeout      time.Duration `mapstructure:"timeout"`       // Time the breaker stays open before moving to half-open
+		MaxRequests  uint32        `mapstructure:"max_requests"`  // Requests allowed through while half-open
+	}
+
+	// Cache contains configuration for the read-through storage cache.
+	Cache struct {
+		Enabled     bool          `mapstructure:"enabled"`      // Whether storage reads are cached
+		NumCounters int64         `mapstructure:"num_counters"` // Number of keys tracked for admission, ~10x the expected number of entries
+		MaxCost     int64         `mapstructure:"max_cost"`     // Maximum size of the cached entries in bytes
+		TTL         time.Duration `mapstructure:"ttl"`          // Lifetime of an entry
+	}
+
+	// Idempotency contains configuration for idempotency keys of mutating requests.
+	Idempotency struct {
+		Enabled         bool          `mapstructure:"enabled"`          // Whether Idempotency-Key headers are honored
+		TTL             time.Duration `mapstructure:"ttl"`              // Window in which retries replay the stored response
+		CleanupInterval time.Duration `mapstructure:"cleanup_interval"` // Interval between deletions of expired keys
+	}
+
+	// Audit contains configuration for the audit trail of mutating calls.
+	Audit struct {
+		Enabled bool `mapstructure:"enabled"` // Whether mutating calls are recorded
+	}
+
+	// Tenancy contains configuration for resolving the tenant of a call.
+	Tenancy struct {
+		Claim         string `mapstructure:"claim"`          // Claim of the authenticated principal naming its tenant
+		DefaultTenant uint64 `mapstructure:"default_tenant"` // Tenant of calls naming none, 0 requires every call to name one
+	}
+
+	// Purge contains configuration for permanently removing soft deleted users.
+	Purge struct {
+		Enabled   bool          `mapstructure:"enabled"`   // Whether soft deleted users are purged
+		Retention time.Duration `mapstructure:"retention"` // Time a soft deleted user can be restored before it is purged
+		Interval  time.Duration `mapstructure:"interval"`  // Interval between purges
+	}
+
+	// Outbox contains configuration for relaying the recorded user events to other systems.
+	Outbox struct {
+		Publisher       string        `mapstructure:"publisher"`        // Publisher the events are relayed through: none, log or webhook
+		Interval        time.Duration `mapstructure:"interval"`         // Interval between relays of pending events
+		BatchSize       int           `mapstructure:"batch_size"`       // Maximum number of events published per relay
+		MaxBackoff      time.Duration `mapstructure:"max_backoff"`      // Longest wait between retries of a failing publish
+		Retention       time.Duration `mapstructure:"retention"`        // Time published events are kept, watchers can resume within it
+		CleanupInterval time.Duration `mapstructure:"cleanup_interval"` // Interval between cleanups of published events
+		Webhook         Webhook       `mapstructure:"webhook"`          // Settings of the webhook publisher
+	}
+
+	// Webhook contains the settings of the webhook event publisher.
+	Webhook struct {
+		URL     string        `mapstructure:"url"`     // Endpoint the events are posted to
+		Secret  string        `mapstructure:"secret"`  // Secret signing the posted bodies, unsigned when empty
+		Timeout time.Duration `mapstructure:"timeout"` // Timeout of a single delivery
+	}
+
+	// Health contains configuration for the health checks.
+	Health struct {
+		ProbeInterval time.Duration `mapstructure:"probe_interval"` // Interval between datastore readiness probes
+	}
+
+	// Pagination contains configuration for paginated list endpoints.
+	Pagination struct {
+		Secret string `mapstructure:"secret"` // Secret used to sign page tokens, a random one is generated when empty
 	}
 
 	// Database contains configuration for the database.
@@ -150,7 +288,7 @@ func NewConfig() (*Config, error) {
 	}
 
 	// Unmarshal the configuration data into the Config struct
-	if err = viper.Unmarshal(cfg); err != nil {
+	if err = Unmarshal(cfg); err != nil {
 		// If there's an error during unmarshalling, return the error with a message
 		return nil, fmt.Errorf("failed to unmarshal server config: %w", err)
 	}
@@ -190,7 +328,7 @@ func NewConfigWithFile(dir string) (*Config, error) {
 	}
 
 	// Unmarshal the configuration data into the Config struct
-	if err = viper.Unmarshal(cfg); err != nil {
+	if err = Unmarshal(cfg); err != nil {
 		// If there's an error during unmarshalling, return the error with a message
 		return nil, fmt.Errorf("failed to unmarshal server config: %w", err)
 	}
@@ -220,6 +358,15 @@ func DefaultConfig() *Config {
 				},
 			},
 			RateLimit: 10_000,
+			ClientRateLimit: ClientRateLimit{
+				Enabled:        false,
+				Key:            "bearer",
+				Rate:           100,
+				Burst:          200,
+				MaxClients:     10_000,
+				TrustedProxies: []string{},
+				Methods:        []MethodRateLimit{},
+			},
 		},
 		Profiler: Profiler{
 			Enabled: false,
@@ -242,10 +389,71 @@ func DefaultConfig() *Config {
 		},
 		Service: Service{
 			CircuitBreaker: false,
+			Breaker: Breaker{
+				MinRequests:  10,
+				FailureRatio: 0.6,
+				Interval:     0,
+				Timeout:      time.Second * 60,
+				MaxRequests:  1,
+			},
+			Cache: Cache{
+				Enabled:     false,
+				NumCounters: 100_000,
+				MaxCost:     64 << 20,
+				TTL:         time.Minute,
+			},
+			Idempotency: Idempotency{
+				Enabled:         true,
+				TTL:             time.Hour * 24,
+				CleanupInterval: time.Hour,
+			},
+			Audit: Audit{
+				Enabled: true,
+			},
+			Tenancy: Tenancy{
+				Claim:         "tenant_id",
+				DefaultTenant: 1,
+			},
+			Purge: Purge{
+				Enabled:   true,
+				Retention: time.Hour * 24 * 30,
+				Interval:  time.Hour,
+			},
+			Outbox: Outbox{
+				Publisher:       "none",
+				Interval:        time.Second,
+				BatchSize:       100,
+				MaxBackoff:      time.Minute,
+				Retention:       time.Hour * 24 * 7,
+				CleanupInterval: time.Hour,
+				Webhook: Webhook{
+					Timeout: time.Second * 10,
+				},
+			},
+			Pagination: Pagination{},
+			Health: Health{
+				ProbeInterval: time.Second * 10,
+			},
 		},
 		Authn: Authn{
 			Enabled:   false,
 			Preshared: Preshared{},
+			Oidc: Oidc{
+				RefreshInterval:   time.Minute * 15,
+				BackoffInterval:   time.Second * 12,
+				BackoffFrequency:  time.Second * 5,
+				BackoffMaxRetries: 5,
+				Leeway:            time.Second * 30,
+				ValidMethods:      []string{"RS256", "ES256"},
+				RolesClaim:        "roles",
+			},
+			ApiKey: ApiKey{
+				CacheTTL:     time.Second * 30,
+				CacheEntries: 10_000,
+			},
+		},
+		Authz: Authz{
+			Enabled: false,
 		},
 		Database: Database{
 			Engine:                "memory",
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"reflect"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// DecodeHook - Decoder option to pass wherever the configuration is unmarshalled. Next to the hooks viper
+// uses by default it accepts preshared keys listed as plain strings, the way they were listed before keys
+// were stored as hashes.
+func DecodeHook() viper.DecoderConfigOption {
+	return viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+		plaintextKeyHook,
+	))
+}
+
+// Unmarshal - Unmarshals the loaded configuration into cfg with DecodeHook. Keys that were renamed are still
+// honoured under their old name as long as the current one is not set.
+func Unmarshal(cfg *Config) error {
+	if err := viper.Unmarshal(cfg, DecodeHook()); err != nil {
+		return err
+	}
+	if viper.IsSet("authn.oidc.backoff_retries") && !viper.IsSet("authn.oidc.backoff_max_retries") {
+		slog.Warn("authn.oidc.backoff_retries is deprecated, use authn.oidc.backoff_max_retries")
+		cfg.Authn.Oidc.BackoffMaxRetries = viper.GetInt("authn.oidc.backoff_retries")
+	}
+	return nil
+}
+
+// PlaintextKey - Returns a key given in plaintext as a key holding its sha256 hash. The id is derived from the
+// hash, so it stays the same wherever the key is listed.
+func PlaintextKey(raw string) PresharedKey {
+	digest := sha256.Sum256([]byte(raw))
+	return PresharedKey{
+		ID:   "key-" + hex.EncodeToString(digest[:4]),
+		Hash: "sha256:" + hex.EncodeToString(digest[:]),
+	}
+}
+
+// plaintextKeyHook decodes a string listed in place of a preshared key as the plaintext of the key
+func plaintextKeyHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if from.Kind() != reflect.String || to != reflect.TypeOf(PresharedKey{}) {
+		return data, nil
+	}
+	raw := reflect.ValueOf(data).String()
+	if raw == "" {
+		return nil, errors.New("pre shared keys can not be empty")
+	}
+	return PlaintextKey(raw), nil
+}
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+var (
+	watchOnce sync.Once
+	watchMu   sync.Mutex
+	watchers  []func(cfg *Config)
+)
+
+// Watch calls fn with the reloaded configuration whenever the config file changes. The file is watched
+// once the first function is registered, changes that can not be unmarshalled are logged and skipped.
+func Watch(fn func(cfg *Config)) {
+	watchMu.Lock()
+	watchers = append(watchers, fn)
+	watchMu.Unlock()
+
+	watchOnce.Do(func() {
+		viper.OnConfigChange(func(_ fsnotify.Event) {
+			cfg := DefaultConfig()
+			if err := Unmarshal(cfg); err != nil {
+				slog.Error("failed to reload config", slog.Any("error", err))
+				return
+			}
+
+			watchMu.Lock()
+			fns := slices.Clone(watchers)
+			watchMu.Unlock()
+
+			for _, fn := range fns {
+				fn(cfg)
+			}
+		})
+		viper.WatchConfig()
+	})
+}
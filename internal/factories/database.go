@@ -4,7 +4,10 @@ import (
 	"fmt"
 
 	"github.com/tolgaOzen/go-skeleton/internal/config"
+	MMRepository "github.com/tolgaOzen/go-skeleton/internal/storage/memory"
+	PQRepository "github.com/tolgaOzen/go-skeleton/internal/storage/postgres"
 	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	MMDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
 	PQDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
 )
 
@@ -21,6 +24,9 @@ import (
 //	- MaxConnectionIdleTime: the maximum amount of time a connection can be idle before being closed
 //	- MaxConnectionLifetime: the maximum amount of time a connection can be reused before being closed
 //
+// PostgreSQL connections carry the tenant of the querying context in a session variable, which the row-level
+// security policies of the migrations check every row against.
+//
 // Returns a database.Database instance if the database connection is successfully created, or an error if the
 // creation fails or the specified database engine is unsupported.
 func DatabaseFactory(conf config.Database) (db database.Database, err error) {
@@ -33,6 +39,7 @@ func DatabaseFactory(conf config.Database) (db database.Database, err error) {
 				PQDatabase.MaxIdleConnections(conf.MaxIdleConnections),
 				PQDatabase.MaxConnectionIdleTime(conf.MaxConnectionIdleTime),
 				PQDatabase.MaxConnectionLifeTime(conf.MaxConnectionLifetime),
+				PQDatabase.SessionVariable(PQRepository.TenantSetting, PQRepository.TenantSession),
 			)
 			if err != nil {
 				return nil, err
@@ -43,6 +50,7 @@ func DatabaseFactory(conf config.Database) (db database.Database, err error) {
 				PQDatabase.MaxIdleConnections(conf.MaxIdleConnections),
 				PQDatabase.MaxConnectionIdleTime(conf.MaxConnectionIdleTime),
 				PQDatabase.MaxConnectionLifeTime(conf.MaxConnectionLifetime),
+				PQDatabase.SessionVariable(PQRepository.TenantSetting, PQRepository.TenantSession),
 			)
 			if err != nil {
 				return nil, err
@@ -50,6 +58,17 @@ func DatabaseFactory(conf config.Database) (db database.Database, err error) {
 		}
 
 		return
+	case database.MEMORY.String():
+		var mm *MMDatabase.Memory
+		mm, err = MMDatabase.New(MMRepository.Schema)
+		if err != nil {
+			return nil, err
+		}
+		// The postgres migrations create the default tenant, the in-memory database starts out empty.
+		if err = MMRepository.Seed(mm); err != nil {
+			return nil, err
+		}
+		return mm, nil
 	default:
 		return nil, fmt.Errorf("%s connection is unsupported", conf.Engine)
 	}
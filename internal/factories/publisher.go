@@ -0,0 +1,30 @@
+package factories
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/internal/publisher"
+)
+
+// EventPublisherFactory creates and returns an EventPublisher based on the configured publisher.
+func EventPublisherFactory(conf config.Outbox) (publisher.EventPublisher, error) {
+	switch conf.Publisher {
+	case "", "none":
+		// Without a publisher events are only kept for watchers until their retention passes
+		return publisher.NewNoop(), nil
+	case "log":
+		// Write the events to stdout, one JSON object per line
+		return publisher.NewLog(os.Stdout), nil
+	case "webhook":
+		// Post the events to the configured endpoint
+		if conf.Webhook.URL == "" {
+			return nil, errors.New("the webhook publisher requires service.outbox.webhook.url")
+		}
+		return publisher.NewWebhook(conf.Webhook.URL, conf.Webhook.Secret, conf.Webhook.Timeout), nil
+	default:
+		return nil, fmt.Errorf("unknown event publisher %q", conf.Publisher)
+	}
+}
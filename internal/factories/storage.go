@@ -2,8 +2,10 @@ package factories
 
 import (
 	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	MMRepository "github.com/tolgaOzen/go-skeleton/internal/storage/memory"
 	PQRepository "github.com/tolgaOzen/go-skeleton/internal/storage/postgres"
 	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	MMDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
 	PQDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
 )
 
@@ -13,9 +15,12 @@ func DataReaderFactory(db database.Database) (repo storage.DataReader) {
 	case "postgres":
 		// If the database engine is Postgres, create a new DataReader using the Postgres implementation
 		return PQRepository.NewDataReader(db.(*PQDatabase.Postgres))
+	case "memory":
+		// If the database engine is memory, create a new DataReader using the in-memory implementation
+		return MMRepository.NewDataReader(db.(*MMDatabase.Memory))
 	default:
-		// For any other type, use the in-memory implementation as a default
-		return PQRepository.NewDataReader(db.(*PQDatabase.Postgres))
+		// For any other type, fall back to a reader that returns no data
+		return storage.NewNoopDataReader()
 	}
 }
 
@@ -25,8 +30,101 @@ func DataWriterFactory(db database.Database) (repo storage.DataWriter) {
 	case "postgres":
 		// If the database engine is Postgres, create a new DataWriter using the Postgres implementation
 		return PQRepository.NewDataWriter(db.(*PQDatabase.Postgres))
+	case "memory":
+		// If the database engine is memory, create a new DataWriter using the in-memory implementation
+		return MMRepository.NewDataWriter(db.(*MMDatabase.Memory))
 	default:
-		// For any other type, use the in-memory implementation as a default
-		return PQRepository.NewDataWriter(db.(*PQDatabase.Postgres))
+		// For any other type, fall back to a writer that discards data
+		return storage.NewNoopDataWriter()
+	}
+}
+
+// WatcherFactory creates and returns a Watcher based on the database engine type.
+func WatcherFactory(db database.Database) (repo storage.Watcher) {
+	switch db.GetEngineType() {
+	case "postgres":
+		// If the database engine is Postgres, create a new Watcher using the Postgres implementation
+		return PQRepository.NewWatcher(db.(*PQDatabase.Postgres))
+	case "memory":
+		// If the database engine is memory, create a new Watcher using the in-memory implementation
+		return MMRepository.NewWatcher(db.(*MMDatabase.Memory))
+	default:
+		// For any other type, fall back to a watcher that never sees a change
+		return storage.NewNoopWatcher()
+	}
+}
+
+// OutboxFactory creates and returns an Outbox based on the database engine type.
+func OutboxFactory(db database.Database) (repo storage.Outbox) {
+	switch db.GetEngineType() {
+	case "postgres":
+		// If the database engine is Postgres, create a new Outbox using the Postgres implementation
+		return PQRepository.NewOutbox(db.(*PQDatabase.Postgres))
+	case "memory":
+		// If the database engine is memory, create a new Outbox using the in-memory implementation
+		return MMRepository.NewOutbox(db.(*MMDatabase.Memory))
+	default:
+		// For any other type, fall back to an outbox that is always empty
+		return storage.NewNoopOutbox()
+	}
+}
+
+// IdempotencyStoreFactory creates and returns an IdempotencyStore based on the database engine type.
+func IdempotencyStoreFactory(db database.Database) (repo storage.IdempotencyStore) {
+	switch db.GetEngineType() {
+	case "postgres":
+		// If the database engine is Postgres, create a new IdempotencyStore using the Postgres implementation
+		return PQRepository.NewIdempotencyStore(db.(*PQDatabase.Postgres))
+	case "memory":
+		// If the database engine is memory, create a new IdempotencyStore using the in-memory implementation
+		return MMRepository.NewIdempotencyStore(db.(*MMDatabase.Memory))
+	default:
+		// For any other type, fall back to a store that never remembers keys
+		return storage.NewNoopIdempotencyStore()
+	}
+}
+
+// AuditStoreFactory creates and returns an AuditStore based on the database engine type.
+func AuditStoreFactory(db database.Database) (repo storage.AuditStore) {
+	switch db.GetEngineType() {
+	case "postgres":
+		// If the database engine is Postgres, create a new AuditStore using the Postgres implementation
+		return PQRepository.NewAuditStore(db.(*PQDatabase.Postgres))
+	case "memory":
+		// If the database engine is memory, create a new AuditStore using the in-memory implementation
+		return MMRepository.NewAuditStore(db.(*MMDatabase.Memory))
+	default:
+		// For any other type, fall back to a store that discards the audit trail
+		return storage.NewNoopAuditStore()
+	}
+}
+
+// TenantStoreFactory creates and returns a TenantStore based on the database engine type.
+func TenantStoreFactory(db database.Database) (repo storage.TenantStore) {
+	switch db.GetEngineType() {
+	case "postgres":
+		// If the database engine is Postgres, create a new TenantStore using the Postgres implementation
+		return PQRepository.NewTenantStore(db.(*PQDatabase.Postgres))
+	case "memory":
+		// If the database engine is memory, create a new TenantStore using the in-memory implementation
+		return MMRepository.NewTenantStore(db.(*MMDatabase.Memory))
+	default:
+		// For any other type, fall back to a store that keeps no tenants
+		return storage.NewNoopTenantStore()
+	}
+}
+
+// ApiKeyStoreFactory creates and returns an ApiKeyStore based on the database engine type.
+func ApiKeyStoreFactory(db database.Database) (repo storage.ApiKeyStore) {
+	switch db.GetEngineType() {
+	case "postgres":
+		// If the database engine is Postgres, create a new ApiKeyStore using the Postgres implementation
+		return PQRepository.NewApiKeyStore(db.(*PQDatabase.Postgres))
+	case "memory":
+		// If the database engine is memory, create a new ApiKeyStore using the in-memory implementation
+		return MMRepository.NewApiKeyStore(db.(*MMDatabase.Memory))
+	default:
+		// For any other type, fall back to a store that keeps no keys
+		return storage.NewNoopApiKeyStore()
 	}
 }
@@ -0,0 +1,211 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"log/slog"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+)
+
+// Audit records an audit event for every call of a mutating method once the call has completed: who made
+// it, what it targeted, a digest of the request, the status code it ended with and whether its response was
+// replayed by Idempotency rather than executed. Methods are considered mutating when their HTTP binding uses
+// POST, PUT, PATCH or DELETE. Audit runs before authorization and Tenancy so rejected calls are recorded too,
+// in the tenant Tenancy resolved or, for calls rejected before, the tenant the credentials are bound to or
+// the default tenant.
+type Audit struct {
+	store storage.AuditStore
+
+	methods sync.Map // full method name -> whether the method is mutating
+}
+
+// NewAudit is a constructor function for Audit.
+func NewAudit(store storage.AuditStore) *Audit {
+	return &Audit{
+		store: store,
+	}
+}
+
+// UnaryServerInterceptor audits the unary calls of mutating methods. The resource id is taken from the
+// response when it carries a resource, otherwise from the id field of the request.
+func (a *Audit) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !a.mutating(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, mark := withAuditMark(ctx)
+		resp, err := handler(ctx, req)
+
+		h := sha256.New()
+		var resourceID string
+		if msg, ok := req.(proto.Message); ok {
+			payload, merr := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+			if merr == nil {
+				h.Write(payload)
+			}
+			resourceID = idField(msg.ProtoReflect())
+		}
+		if msg, ok := resp.(proto.Message); ok && err == nil {
+			if id := responseID(msg.ProtoReflect()); id != "" {
+				resourceID = id
+			}
+		}
+
+		a.record(ctx, mark, info.FullMethod, resourceID, h, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor audits the streaming calls of mutating methods. The digest covers every received
+// message prefixed by its length, the resource id is left empty since a stream targets many resources.
+func (a *Audit) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !a.mutating(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx, mark := withAuditMark(ss.Context())
+		stream := &auditedStream{ServerStream: ss, ctx: ctx, digest: sha256.New()}
+		err := handler(srv, stream)
+
+		a.record(ss.Context(), mark, info.FullMethod, "", stream.digest, err)
+		return err
+	}
+}
+
+// record writes the audit event. The call has already completed at this point, so a failed write is only
+// logged and the outcome of the call is left untouched.
+func (a *Audit) record(ctx context.Context, mark *auditMark, method, resourceID string, digest hash.Hash, err error) {
+	event := storage.AuditEvent{
+		Actor:         subject(ctx),
+		Method:        method,
+		ResourceID:    resourceID,
+		RequestDigest: hex.EncodeToString(digest.Sum(nil)),
+		Code:          status.Code(err).String(),
+		Replayed:      mark.replayed,
+	}
+
+	// Calls rejected before Tenancy resolved their tenant are recorded where the caller belongs.
+	tenantID, ok := mark.tenant, mark.tenant != 0
+	if !ok {
+		tenantID, ok = tenancy.FromContext(ctx)
+	}
+	if p, authenticated := authn.FromContext(ctx); !ok && authenticated && p.TenantID != 0 {
+		tenantID, ok = p.TenantID, true
+	}
+	if !ok {
+		tenantID = tenancy.DefaultTenant
+	}
+	ctx = tenancy.NewContext(ctx, tenantID)
+
+	// Detach from the request so a client that hung up still leaves a trail.
+	if werr := a.store.Write(context.WithoutCancel(ctx), event); werr != nil {
+		slog.ErrorContext(ctx, "failed to write audit event", slog.String("method", method), slog.Any("error", werr))
+	}
+}
+
+// mutating resolves and caches whether the method changes state
+func (a *Audit) mutating(fullMethod string) bool {
+	if v, ok := a.methods.Load(fullMethod); ok {
+		return v.(bool)
+	}
+
+	md, ok := methodDescriptor(fullMethod)
+	m := ok && mutating(md)
+
+	a.methods.Store(fullMethod, m)
+	return m
+}
+
+// auditMark is filled in by interceptors further down the chain: Tenancy sets the tenant it resolved, and
+// Idempotency flags calls answered without running the handler, so the audit event tells replays apart from
+// executions.
+type auditMark struct {
+	tenant   uint64
+	replayed bool
+}
+
+type auditMarkKey struct{}
+
+// withAuditMark returns a copy of the context carrying a fresh audit mark
+func withAuditMark(ctx context.Context) (context.Context, *auditMark) {
+	mark := &auditMark{}
+	return context.WithValue(ctx, auditMarkKey{}, mark), mark
+}
+
+// markTenant records the tenant of the call, a no-op when the call is not audited
+func markTenant(ctx context.Context, tenantID uint64) {
+	if mark, ok := ctx.Value(auditMarkKey{}).(*auditMark); ok {
+		mark.tenant = tenantID
+	}
+}
+
+// markReplayed flags the call as replayed, a no-op when the call is not audited
+func markReplayed(ctx context.Context) {
+	if mark, ok := ctx.Value(auditMarkKey{}).(*auditMark); ok {
+		mark.replayed = true
+	}
+}
+
+// auditedStream hashes the messages received from the client and carries the audit mark
+type auditedStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	digest hash.Hash
+}
+
+func (s *auditedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err == nil {
+			s.digest.Write(protowire.AppendVarint(nil, uint64(len(payload))))
+			s.digest.Write(payload)
+		}
+	}
+	return nil
+}
+
+// responseID returns the id of the first resource set in the response
+func responseID(m protoreflect.Message) string {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || !m.Has(fd) {
+			continue
+		}
+		if id := idField(m.Get(fd).Message()); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// idField returns the id field of the message formatted as a string, empty when it has none
+func idField(m protoreflect.Message) string {
+	fd := m.Descriptor().Fields().ByName("id")
+	if fd == nil || fd.IsList() || fd.IsMap() || fd.Kind() == protoreflect.MessageKind || !m.Has(fd) {
+		return ""
+	}
+	return fmt.Sprint(m.Get(fd).Interface())
+}
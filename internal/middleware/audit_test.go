@@ -0,0 +1,246 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/authz"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	memoryStorage "github.com/tolgaOzen/go-skeleton/internal/storage/memory"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	"github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+func TestAudit(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	store := memoryStorage.NewAuditStore(db)
+	interceptor := NewAudit(store).UnaryServerInterceptor()
+	ctx := authn.NewContext(context.Background(), authn.Principal{Subject: "alice"})
+
+	list := func() []storage.AuditEvent {
+		events, _, err := store.List(context.Background(), storage.AuditFilter{}, database.NewPagination(database.Size(10)))
+		assert.NoError(t, err)
+		return events
+	}
+
+	// the created user is the resource of a create
+	req := &base.UserCreateRequest{Id: "ext-1", Name: "tolga"}
+	_, err = interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Create"}, func(_ context.Context, _ interface{}) (interface{}, error) {
+		return &base.UserCreateResponse{User: &base.User{Id: 7}}, nil
+	})
+	assert.NoError(t, err)
+
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	assert.NoError(t, err)
+	sum := sha256.Sum256(payload)
+
+	events := list()
+	assert.Len(t, events, 1)
+	assert.Equal(t, "alice", events[0].Actor)
+	assert.Equal(t, "/base.v1.UserService/Create", events[0].Method)
+	assert.Equal(t, "7", events[0].ResourceID)
+	assert.Equal(t, hex.EncodeToString(sum[:]), events[0].RequestDigest)
+	assert.Equal(t, "OK", events[0].Code)
+
+	// failed calls are recorded with their code and the id of the request
+	_, err = interceptor(ctx, &base.UserDeleteRequest{Id: 9}, &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Delete"}, func(_ context.Context, _ interface{}) (interface{}, error) {
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_NOT_FOUND)
+	})
+	assert.Error(t, err)
+
+	events = list()
+	assert.Len(t, events, 2)
+	assert.Equal(t, "9", events[0].ResourceID)
+	assert.Equal(t, "NotFound", events[0].Code)
+
+	// reads are not audited
+	_, err = interceptor(ctx, &base.UserGetRequest{Id: 7}, &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Get"}, func(_ context.Context, _ interface{}) (interface{}, error) {
+		return &base.UserGetResponse{}, nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, list(), 2)
+}
+
+func TestAuditRejectedCalls(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	authorizer, err := authz.New(authz.Policy{Roles: []authz.Role{{Name: "viewer", Permissions: []string{"users.read"}}}})
+	assert.NoError(t, err)
+
+	store := memoryStorage.NewAuditStore(db)
+	audit := NewAudit(store).UnaryServerInterceptor()
+	authorization := NewAuthorization(authorizer).UnaryServerInterceptor()
+	tn := NewTenancy(config.Tenancy{DefaultTenant: 1}, authorizer).UnaryServerInterceptor()
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Delete"}
+	req := &base.UserDeleteRequest{Id: 9}
+
+	// audit runs before authorization and tenancy, as in the server chain
+	call := func(ctx context.Context) error {
+		_, err := audit(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return authorization(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return tn(ctx, req, info, func(_ context.Context, _ interface{}) (interface{}, error) {
+					return &base.MessageResponse{}, nil
+				})
+			})
+		})
+		return err
+	}
+
+	list := func(tenantID uint64) []storage.AuditEvent {
+		events, _, err := store.List(tenancy.NewContext(context.Background(), tenantID), storage.AuditFilter{}, database.NewPagination(database.Size(10)))
+		assert.NoError(t, err)
+		return events
+	}
+
+	// a call the roles of the caller do not permit is recorded with its rejection, in the tenant of the credentials
+	viewer := authn.NewContext(context.Background(), authn.Principal{Subject: "alice", TenantID: 2, Roles: []string{"viewer"}})
+	assert.Error(t, call(viewer))
+
+	events := list(2)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "alice", events[0].Actor)
+	assert.Equal(t, "PermissionDenied", events[0].Code)
+	assert.Equal(t, "9", events[0].ResourceID)
+
+	// so is a call naming a tenant the caller can not act in, in the default tenant
+	unbound := authn.NewContext(context.Background(), authn.Principal{Subject: "bob", Roles: []string{"admin"}})
+	other := metadata.NewIncomingContext(unbound, metadata.Pairs(TenantHeader, "3"))
+	_, err = audit(other, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return tn(ctx, req, info, func(_ context.Context, _ interface{}) (interface{}, error) {
+			return &base.MessageResponse{}, nil
+		})
+	})
+	assert.Error(t, err)
+
+	events = list(tenancy.DefaultTenant)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "bob", events[0].Actor)
+	assert.Equal(t, "PermissionDenied", events[0].Code)
+
+	// calls that pass are recorded in the tenant tenancy resolved
+	_, err = audit(metadata.NewIncomingContext(context.Background(), metadata.Pairs(TenantHeader, "3")), req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return tn(ctx, req, info, func(_ context.Context, _ interface{}) (interface{}, error) {
+			return &base.MessageResponse{}, nil
+		})
+	})
+	assert.NoError(t, err)
+
+	events = list(3)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "OK", events[0].Code)
+}
+
+func TestAuditIdempotentReplay(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	store := memoryStorage.NewAuditStore(db)
+	audit := NewAudit(store).UnaryServerInterceptor()
+	idempotency := NewIdempotency(memoryStorage.NewIdempotencyStore(db), time.Hour).UnaryServerInterceptor()
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Create"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, "key-1"))
+	req := &base.UserCreateRequest{Id: "ext-1", Name: "tolga"}
+
+	// audit runs first, as in the server chain
+	call := func() {
+		_, err := audit(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return idempotency(ctx, req, info, func(_ context.Context, _ interface{}) (interface{}, error) {
+				return &base.UserCreateResponse{User: &base.User{Id: 7}}, nil
+			})
+		})
+		assert.NoError(t, err)
+	}
+
+	call()
+	call()
+
+	events, _, err := store.List(context.Background(), storage.AuditFilter{}, database.NewPagination(database.Size(10)))
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	// the retry is recorded as a replay of the same resource, latest first
+	assert.True(t, events[0].Replayed)
+	assert.Equal(t, "7", events[0].ResourceID)
+	assert.False(t, events[1].Replayed)
+}
+
+// importStream is a client stream delivering the given requests
+type importStream struct {
+	grpc.ServerStream
+	requests []*base.UserImportRequest
+}
+
+func (s *importStream) Context() context.Context {
+	return authn.NewContext(context.Background(), authn.Principal{Subject: "bob"})
+}
+
+func (s *importStream) RecvMsg(m interface{}) error {
+	if len(s.requests) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.requests[0])
+	s.requests = s.requests[1:]
+	return nil
+}
+
+func TestAuditStream(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	store := memoryStorage.NewAuditStore(db)
+	interceptor := NewAudit(store).StreamServerInterceptor()
+
+	digest := func(requests ...*base.UserImportRequest) string {
+		ss := &importStream{requests: requests}
+		err := interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: "/base.v1.UserService/Import"}, func(_ interface{}, stream grpc.ServerStream) error {
+			for {
+				if err := stream.RecvMsg(&base.UserImportRequest{}); err != nil {
+					if errors.Is(err, io.EOF) {
+						return nil
+					}
+					return err
+				}
+			}
+		})
+		assert.NoError(t, err)
+
+		events, _, err := store.List(context.Background(), storage.AuditFilter{}, database.NewPagination(database.Size(1)))
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, "bob", events[0].Actor)
+		assert.Equal(t, "OK", events[0].Code)
+		assert.Empty(t, events[0].ResourceID)
+		return events[0].RequestDigest
+	}
+
+	// the digest covers every received message and their boundaries
+	a := digest(&base.UserImportRequest{Id: "1", Name: "a"}, &base.UserImportRequest{Id: "2", Name: "b"})
+	b := digest(&base.UserImportRequest{Id: "1", Name: "a"}, &base.UserImportRequest{Id: "2", Name: "b"})
+	c := digest(&base.UserImportRequest{Id: "1", Name: "a"})
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+}
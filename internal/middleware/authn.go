@@ -11,10 +11,6 @@ import (
 // AuthFunc - Middleware that responsible for key authentication
 func AuthFunc(authenticator authn.Authenticator) grpcAuth.AuthFunc {
 	return func(ctx context.Context) (context.Context, error) {
-		err := authenticator.Authenticate(ctx)
-		if err != nil {
-			return nil, err
-		}
-		return ctx, nil
+		return authenticator.Authenticate(ctx)
 	}
 }
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/authz"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// Authorization denies the calls whose principal is not granted the permissions the method requires. Methods
+// declare them with the permissions option of their rpc unless the policy overrides them, methods requiring
+// none, such as the health and reflection services, are open to every authenticated caller.
+type Authorization struct {
+	authorizer *authz.Authorizer
+	methods    sync.Map // full method name -> []string
+}
+
+// NewAuthorization is a constructor function for Authorization.
+func NewAuthorization(authorizer *authz.Authorizer) *Authorization {
+	return &Authorization{
+		authorizer: authorizer,
+	}
+}
+
+// Authorizer returns the authorizer calls are checked with.
+func (a *Authorization) Authorizer() *authz.Authorizer {
+	return a.authorizer
+}
+
+// UnaryServerInterceptor authorizes unary calls.
+func (a *Authorization) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authorizes streaming calls.
+func (a *Authorization) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authorize checks the roles of the principal against the permissions of the method
+func (a *Authorization) authorize(ctx context.Context, fullMethod string) error {
+	required := a.required(fullMethod)
+	if len(required) == 0 {
+		return nil
+	}
+
+	var roles []string
+	if p, ok := authn.FromContext(ctx); ok {
+		roles = p.Roles
+	}
+
+	if missing := a.authorizer.Missing(roles, required); missing != "" {
+		return apierrors.New(base.ErrorCode_ERROR_CODE_PERMISSION_DENIED).WithMetadata("permission", missing)
+	}
+	return nil
+}
+
+// required returns the permissions of the method, the policy takes precedence over the permissions option
+func (a *Authorization) required(fullMethod string) []string {
+	if permissions, ok := a.authorizer.Override(fullMethod); ok {
+		return permissions
+	}
+	if v, ok := a.methods.Load(fullMethod); ok {
+		return v.([]string)
+	}
+
+	var permissions []string
+	if md, ok := methodDescriptor(fullMethod); ok {
+		if opts, ok := md.Options().(*descriptorpb.MethodOptions); ok && opts != nil {
+			permissions, _ = proto.GetExtension(opts, base.E_Permissions).([]string)
+		}
+	}
+	a.methods.Store(fullMethod, permissions)
+	return permissions
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/authz"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+func TestAuthorization(t *testing.T) {
+	authorizer, err := authz.New(authz.Policy{
+		Roles: []authz.Role{
+			{Name: "viewer", Permissions: []string{"users.read"}},
+			{Name: "editor", Permissions: []string{"users.*"}},
+		},
+		Methods: []authz.MethodPermissions{
+			{Method: "/base.v1.UserService/Get", Permissions: []string{"users.get"}},
+		},
+	})
+	assert.NoError(t, err)
+	interceptor := NewAuthorization(authorizer).UnaryServerInterceptor()
+
+	call := func(roles []string, method string) error {
+		ctx := authn.NewContext(context.Background(), authn.Principal{Subject: "alice", Roles: roles})
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(_ context.Context, _ interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+
+	// the permissions option of the rpc is required
+	assert.NoError(t, call([]string{"viewer"}, "/base.v1.UserService/List"))
+	assert.NoError(t, call([]string{"editor"}, "/base.v1.UserService/Delete"))
+
+	err = call([]string{"viewer"}, "/base.v1.UserService/Delete")
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_PERMISSION_DENIED, apierrors.FromError(err).Code)
+	assert.Equal(t, "users.delete", apierrors.FromError(err).Metadata["permission"])
+
+	err = call(nil, "/base.v1.AuditService/ListAuditEvents")
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_PERMISSION_DENIED, apierrors.FromError(err).Code)
+
+	// the policy overrides the option
+	assert.Error(t, call([]string{"viewer"}, "/base.v1.UserService/Get"))
+	assert.NoError(t, call([]string{"editor"}, "/base.v1.UserService/Get"))
+
+	// methods requiring no permissions are open
+	assert.NoError(t, call(nil, "/grpc.health.v1.Health/Check"))
+
+	// streams are authorized as well
+	stream := NewAuthorization(authorizer).StreamServerInterceptor()
+	ss := &tenantStream{ctx: authn.NewContext(context.Background(), authn.Principal{Roles: []string{"viewer"}})}
+	err = stream(nil, ss, &grpc.StreamServerInfo{FullMethod: "/base.v1.UserService/Import"}, func(_ interface{}, _ grpc.ServerStream) error {
+		t.Fatal("handler called without permission")
+		return nil
+	})
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_PERMISSION_DENIED, apierrors.FromError(err).Code)
+}
+
+// every rpc declares its permissions, a method without them would be open to every caller
+func TestPermissionsDeclared(t *testing.T) {
+	services := base.File_base_v1_service_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			md := methods.Get(j)
+			permissions := proto.GetExtension(md.Options().(*descriptorpb.MethodOptions), base.E_Permissions).([]string)
+			assert.NotEmpty(t, permissions, md.FullName())
+		}
+	}
+}
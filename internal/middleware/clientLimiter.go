@@ -0,0 +1,274 @@
+package middleware
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/juju/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// Rate limit headers sent with every limited request, the gateway forwards them as HTTP headers.
+const (
+	RetryAfterHeader         = "retry-after"
+	RateLimitLimitHeader     = "x-ratelimit-limit"
+	RateLimitRemainingHeader = "x-ratelimit-remaining"
+	RateLimitResetHeader     = "x-ratelimit-reset"
+)
+
+// limit is the rate and burst of a bucket
+type limit struct {
+	rate  int64
+	burst int64
+}
+
+// entry is a bucket in the LRU list
+type entry struct {
+	key    string
+	bucket *ratelimit.Bucket
+}
+
+// ClientRateLimiter keeps a token bucket per client identity, so a noisy client only exhausts its own bucket.
+// Buckets live in an LRU list, the least recently used ones are evicted once MaxClients is reached and start
+// full again when the client comes back. Clients are identified by their peer address, x-forwarded-for is only
+// honoured for requests arriving through the gateway, over loopback, or from one of the trusted proxies.
+type ClientRateLimiter struct {
+	key        string
+	defaults   limit
+	methods    map[string]limit
+	maxClients int
+	proxies    []*net.IPNet
+
+	mu      sync.Mutex
+	ll      *list.List
+	buckets map[string]*list.Element
+}
+
+// NewClientRateLimiter is a constructor function for ClientRateLimiter.
+func NewClientRateLimiter(cfg config.ClientRateLimit) (*ClientRateLimiter, error) {
+	switch cfg.Key {
+	case "bearer", "ip", "subject":
+	default:
+		return nil, fmt.Errorf("unknown client rate limit key: '%s'", cfg.Key)
+	}
+
+	if cfg.Rate <= 0 {
+		return nil, fmt.Errorf("client rate limit must be positive, got %d", cfg.Rate)
+	}
+
+	methods := make(map[string]limit, len(cfg.Methods))
+	for _, m := range cfg.Methods {
+		if m.Rate <= 0 {
+			return nil, fmt.Errorf("rate limit of method '%s' must be positive, got %d", m.Method, m.Rate)
+		}
+		methods[m.Method] = limit{rate: m.Rate, burst: max(m.Burst, 1)}
+	}
+
+	proxies := make([]*net.IPNet, 0, len(cfg.TrustedProxies))
+	for _, p := range cfg.TrustedProxies {
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return nil, fmt.Errorf("trusted proxy '%s' is not an address or a CIDR range", p)
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy '%s' is not an address or a CIDR range", p)
+		}
+		proxies = append(proxies, network)
+	}
+
+	return &ClientRateLimiter{
+		key:        cfg.Key,
+		defaults:   limit{rate: cfg.Rate, burst: max(cfg.Burst, 1)},
+		methods:    methods,
+		maxClients: max(cfg.MaxClients, 1),
+		proxies:    proxies,
+		ll:         list.New(),
+		buckets:    map[string]*list.Element{},
+	}, nil
+}
+
+// Allow takes a token from the bucket of the client calling the method. It returns the metadata describing the
+// state of the bucket and, when the bucket is empty, a RESOURCE_EXHAUSTED error.
+func (l *ClientRateLimiter) Allow(ctx context.Context, method string) (metadata.MD, error) {
+	lim, overridden := l.methods[method]
+	if !overridden {
+		lim = l.defaults
+	}
+
+	// Methods with their own limit get their own bucket so they do not consume the default one.
+	key := l.identity(ctx)
+	if overridden {
+		key = method + "|" + key
+	}
+
+	bucket := l.bucket(key, lim)
+	taken := bucket.TakeAvailable(1)
+	remaining := bucket.Available()
+
+	md := metadata.Pairs(
+		RateLimitLimitHeader, strconv.FormatInt(lim.burst, 10),
+		RateLimitRemainingHeader, strconv.FormatInt(remaining, 10),
+		RateLimitResetHeader, strconv.FormatInt(seconds(lim.burst-remaining, lim.rate), 10),
+	)
+
+	if taken == 0 {
+		retryAfter := strconv.FormatInt(seconds(1, lim.rate), 10)
+		md.Set(RetryAfterHeader, retryAfter)
+		return md, apierrors.New(base.ErrorCode_ERROR_CODE_RESOURCE_EXHAUSTED).
+			WithMessage("rate limit exceeded").
+			WithMetadata("retry_after", retryAfter)
+	}
+
+	return md, nil
+}
+
+// bucket returns the bucket of the key, creating it and evicting the least recently used one when needed.
+func (l *ClientRateLimiter) bucket(key string, lim limit) *ratelimit.Bucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.buckets[key]; ok {
+		l.ll.MoveToFront(el)
+		return el.Value.(*entry).bucket
+	}
+
+	if l.ll.Len() >= l.maxClients {
+		if oldest := l.ll.Back(); oldest != nil {
+			l.ll.Remove(oldest)
+			delete(l.buckets, oldest.Value.(*entry).key)
+		}
+	}
+
+	b := ratelimit.NewBucketWithRate(float64(lim.rate), lim.burst)
+	l.buckets[key] = l.ll.PushFront(&entry{key: key, bucket: b})
+	return b
+}
+
+// identity returns the key of the client, falling back to the client address when the configured identity is missing.
+func (l *ClientRateLimiter) identity(ctx context.Context) string {
+	switch l.key {
+	case "subject":
+		if p, ok := authn.FromContext(ctx); ok && p.Subject != "" {
+			return "subject:" + p.Subject
+		}
+	case "bearer":
+		// Tokens are only keyed by once authentication verified them, made up ones would each get a fresh bucket.
+		if _, ok := authn.FromContext(ctx); ok {
+			if token, err := grpcAuth.AuthFromMD(ctx, "Bearer"); err == nil && token != "" {
+				// Only a digest is kept so the limiter never holds credentials.
+				sum := sha256.Sum256([]byte(token))
+				return "bearer:" + hex.EncodeToString(sum[:16])
+			}
+		}
+	}
+	return "ip:" + l.clientIP(ctx)
+}
+
+// clientIP returns the originating address. Requests from trusted proxies carry it in x-forwarded-for, which is
+// walked from the right, every proxy appends the address it received the request from, up to the first untrusted hop.
+func (l *ClientRateLimiter) clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	addr := net.ParseIP(host)
+	if addr == nil {
+		return host
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok && l.trusted(addr) {
+		hops := strings.Split(strings.Join(md.Get("x-forwarded-for"), ","), ",")
+		for i := len(hops) - 1; i >= 0 && l.trusted(addr); i-- {
+			hop := net.ParseIP(strings.TrimSpace(hops[i]))
+			if hop == nil {
+				break
+			}
+			addr = hop
+		}
+	}
+	return addr.String()
+}
+
+// trusted reports whether the address is the gateway's, which dials over loopback, or one of a trusted proxy.
+func (l *ClientRateLimiter) trusted(ip net.IP) bool {
+	if ip.IsLoopback() {
+		return true
+	}
+	for _, network := range l.proxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// seconds returns the whole seconds, at least one, needed to refill n tokens at the given rate.
+func seconds(n, rate int64) int64 {
+	if n <= 0 {
+		return 0
+	}
+	return max(int64(math.Ceil(float64(n)/float64(rate))), 1)
+}
+
+// exempt reports whether the method is never rate limited, health probes must keep working for exhausted clients.
+func exempt(method string) bool {
+	return strings.HasPrefix(method, "/grpc.health.v1.Health/")
+}
+
+// UnaryServerInterceptor rate limits unary calls per client and reports the bucket state in the headers,
+// rejected calls additionally carry it in the trailers.
+func UnaryServerInterceptor(l *ClientRateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if exempt(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		md, err := l.Allow(ctx, info.FullMethod)
+		_ = grpc.SetHeader(ctx, md)
+		if err != nil {
+			_ = grpc.SetTrailer(ctx, md)
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rate limits the opening of streams per client.
+func StreamServerInterceptor(l *ClientRateLimiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if exempt(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		md, err := l.Allow(ss.Context(), info.FullMethod)
+		_ = ss.SetHeader(md)
+		if err != nil {
+			ss.SetTrailer(md)
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+)
+
+func fromIP(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 4000}})
+}
+
+func TestClientRateLimiter(t *testing.T) {
+	l, err := NewClientRateLimiter(config.ClientRateLimit{Key: "ip", Rate: 1, Burst: 2, MaxClients: 10})
+	assert.NoError(t, err)
+
+	a, b := fromIP("10.0.0.1"), fromIP("10.0.0.2")
+
+	md, err := l.Allow(a, "/base.v1.UserService/List")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2"}, md.Get(RateLimitLimitHeader))
+	assert.Equal(t, []string{"1"}, md.Get(RateLimitRemainingHeader))
+
+	_, err = l.Allow(a, "/base.v1.UserService/List")
+	assert.NoError(t, err)
+
+	// the bucket of a is empty
+	md, err = l.Allow(a, "/base.v1.UserService/List")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{"1"}, md.Get(RetryAfterHeader))
+	assert.Equal(t, []string{"0"}, md.Get(RateLimitRemainingHeader))
+
+	// other clients are not affected
+	_, err = l.Allow(b, "/base.v1.UserService/List")
+	assert.NoError(t, err)
+}
+
+func TestClientRateLimiterMethodOverride(t *testing.T) {
+	l, err := NewClientRateLimiter(config.ClientRateLimit{
+		Key: "ip", Rate: 100, Burst: 100, MaxClients: 10,
+		Methods: []config.MethodRateLimit{{Method: "/base.v1.UserService/Create", Rate: 1, Burst: 1}},
+	})
+	assert.NoError(t, err)
+
+	ctx := fromIP("10.0.0.1")
+	_, err = l.Allow(ctx, "/base.v1.UserService/Create")
+	assert.NoError(t, err)
+	_, err = l.Allow(ctx, "/base.v1.UserService/Create")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the default bucket is untouched
+	md, err := l.Allow(ctx, "/base.v1.UserService/List")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"99"}, md.Get(RateLimitRemainingHeader))
+}
+
+func TestClientRateLimiterEviction(t *testing.T) {
+	l, err := NewClientRateLimiter(config.ClientRateLimit{Key: "ip", Rate: 1, Burst: 1, MaxClients: 1})
+	assert.NoError(t, err)
+
+	a, b := fromIP("10.0.0.1"), fromIP("10.0.0.2")
+
+	_, err = l.Allow(a, "/m")
+	assert.NoError(t, err)
+	_, err = l.Allow(a, "/m")
+	assert.Error(t, err)
+
+	// b evicts the idle bucket of a, which starts full again
+	_, err = l.Allow(b, "/m")
+	assert.NoError(t, err)
+	_, err = l.Allow(a, "/m")
+	assert.NoError(t, err)
+}
+
+func TestClientRateLimiterIdentity(t *testing.T) {
+	bearer, err := NewClientRateLimiter(config.ClientRateLimit{Key: "bearer", Rate: 1, Burst: 1})
+	assert.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(fromIP("10.0.0.1"), metadata.Pairs("authorization", "Bearer secret"))
+	authenticated := authn.NewContext(ctx, authn.Principal{Subject: "user-1"})
+	assert.NotContains(t, bearer.identity(authenticated), "secret")
+	assert.Contains(t, bearer.identity(authenticated), "bearer:")
+
+	// tokens authentication did not verify do not get their own bucket
+	assert.Equal(t, "ip:10.0.0.1", bearer.identity(ctx))
+
+	// requests without a token fall back to the address
+	assert.Equal(t, "ip:10.0.0.1", bearer.identity(fromIP("10.0.0.1")))
+
+	subject, err := NewClientRateLimiter(config.ClientRateLimit{Key: "subject", Rate: 1, Burst: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "subject:user-1", subject.identity(authn.NewContext(context.Background(), authn.Principal{Subject: "user-1"})))
+
+	_, err = NewClientRateLimiter(config.ClientRateLimit{Key: "cookie", Rate: 1})
+	assert.Error(t, err)
+}
+
+func TestClientRateLimiterForwardedFor(t *testing.T) {
+	l, err := NewClientRateLimiter(config.ClientRateLimit{Key: "ip", Rate: 1, Burst: 1, TrustedProxies: []string{"10.0.0.0/8", "192.0.2.1"}})
+	assert.NoError(t, err)
+
+	forwarded := func(ip, xff string) string {
+		return l.identity(metadata.NewIncomingContext(fromIP(ip), metadata.Pairs("x-forwarded-for", xff)))
+	}
+
+	// clients can not pick their address by setting the header themselves
+	assert.Equal(t, "ip:203.0.113.7", forwarded("203.0.113.7", "198.51.100.1"))
+
+	// the gateway appends the address it was called from to the header of the client
+	assert.Equal(t, "ip:198.51.100.9", forwarded("127.0.0.1", "198.51.100.1, 198.51.100.9"))
+
+	// trusted proxies are skipped up to the first untrusted hop
+	assert.Equal(t, "ip:198.51.100.9", forwarded("127.0.0.1", "198.51.100.1, 198.51.100.9, 10.0.0.2, 192.0.2.1"))
+	assert.Equal(t, "ip:10.0.0.2", forwarded("192.0.2.1", "10.0.0.2"))
+	assert.Equal(t, "ip:10.0.0.2", forwarded("127.0.0.1", "garbage, 10.0.0.2"))
+
+	_, err = NewClientRateLimiter(config.ClientRateLimit{Key: "ip", Rate: 1, TrustedProxies: []string{"proxy"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,264 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client chosen key, the gateway forwards the Idempotency-Key HTTP header as it.
+	IdempotencyKeyHeader = "idempotency-key"
+	// IdempotentReplayedHeader is set on responses replayed from a previous request.
+	IdempotentReplayedHeader = "idempotent-replayed"
+
+	// maxIdempotencyKeyLength bounds the size of client supplied keys.
+	maxIdempotencyKeyLength = 255
+)
+
+// Idempotency makes mutating RPCs safe to retry. The first request carrying a key reserves it, its
+// response is stored and replayed to every retry with the same key until the key expires. Retries
+// with a different payload are rejected, as are retries arriving while the first request still runs.
+type Idempotency struct {
+	store storage.IdempotencyStore
+	ttl   time.Duration
+
+	methods sync.Map // full method name -> methodInfo
+}
+
+// methodInfo describes how a method takes part in idempotency
+type methodInfo struct {
+	mutating bool
+	output   protoreflect.MessageType
+}
+
+// NewIdempotency is a constructor function for Idempotency.
+func NewIdempotency(store storage.IdempotencyStore, ttl time.Duration) *Idempotency {
+	return &Idempotency{
+		store: store,
+		ttl:   ttl,
+	}
+}
+
+// Run deletes expired keys every interval until the context is canceled.
+func (i *Idempotency) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			deleted, err := i.store.DeleteExpired(ctx, time.Now())
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to delete expired idempotency keys", slog.Any("error", err))
+				continue
+			}
+			if deleted > 0 {
+				slog.DebugContext(ctx, "deleted expired idempotency keys", slog.Int64("count", deleted))
+			}
+		}
+	}
+}
+
+// UnaryServerInterceptor applies idempotency keys to the unary calls of mutating methods, methods
+// are considered mutating when their HTTP binding uses POST, PUT, PATCH or DELETE. Methods marked with
+// the secret_response option are left out, their responses are never stored.
+func (i *Idempotency) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		idempotencyKey := incomingKey(ctx)
+		if idempotencyKey == "" {
+			return handler(ctx, req)
+		}
+
+		m := i.method(info.FullMethod)
+		if !m.mutating {
+			return handler(ctx, req)
+		}
+
+		if len(idempotencyKey) > maxIdempotencyKeyLength {
+			return nil, apierrors.Newf(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, "idempotency key must not exceed %d bytes", maxIdempotencyKeyLength)
+		}
+
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return nil, apierrors.Wrap(base.ErrorCode_ERROR_CODE_SERIALIZATION, err)
+		}
+
+		// Keys are scoped to the tenant and the caller and stored hashed, the request hash covers
+		// the method so a key reused for another method is reported as a mismatch.
+		key := digest(strconv.FormatUint(tenancy.ID(ctx), 10), subject(ctx), idempotencyKey)
+		requestHash := digest(info.FullMethod, string(payload))
+
+		existing, err := i.store.Reserve(ctx, storage.IdempotencyRecord{
+			Key:         hex.EncodeToString(key),
+			Method:      info.FullMethod,
+			RequestHash: requestHash,
+			ExpiresAt:   time.Now().Add(i.ttl),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if existing != nil {
+			return i.replay(ctx, m, existing, requestHash)
+		}
+
+		resp, err := handler(ctx, req)
+		// Detach from the request so a client that hung up does not leave the key reserved.
+		storeCtx := context.WithoutCancel(ctx)
+		if err != nil {
+			if rerr := i.store.Release(storeCtx, hex.EncodeToString(key)); rerr != nil {
+				slog.ErrorContext(ctx, "failed to release idempotency key", slog.Any("error", rerr))
+			}
+			return nil, err
+		}
+
+		out, err := proto.Marshal(resp.(proto.Message))
+		if err == nil {
+			err = i.store.Complete(storeCtx, hex.EncodeToString(key), out)
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to store idempotent response", slog.Any("error", err))
+			if rerr := i.store.Release(storeCtx, hex.EncodeToString(key)); rerr != nil {
+				slog.ErrorContext(ctx, "failed to release idempotency key", slog.Any("error", rerr))
+			}
+		}
+
+		return resp, nil
+	}
+}
+
+// replay returns the stored response of the request that reserved the key
+func (i *Idempotency) replay(ctx context.Context, m methodInfo, existing *storage.IdempotencyRecord, requestHash []byte) (interface{}, error) {
+	if !bytes.Equal(existing.RequestHash, requestHash) {
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_MISMATCH).
+			WithMessage("idempotency key was already used with a different request")
+	}
+
+	if existing.Pending() {
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_IN_USE).
+			WithMessage("a request with this idempotency key is still in progress")
+	}
+
+	resp := m.output.New().Interface()
+	if err := proto.Unmarshal(existing.Response, resp); err != nil {
+		return nil, apierrors.Wrap(base.ErrorCode_ERROR_CODE_SERIALIZATION, err)
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayedHeader, "true"))
+	markReplayed(ctx)
+	return resp, nil
+}
+
+// method resolves and caches whether the method is mutating and its response type
+func (i *Idempotency) method(fullMethod string) methodInfo {
+	if v, ok := i.methods.Load(fullMethod); ok {
+		return v.(methodInfo)
+	}
+
+	var info methodInfo
+	if md, ok := methodDescriptor(fullMethod); ok {
+		output, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+		if err == nil {
+			// Responses carrying secrets are never stored, such methods are not made idempotent
+			info = methodInfo{mutating: mutating(md) && !secretResponse(md), output: output}
+		}
+	}
+
+	i.methods.Store(fullMethod, info)
+	return info
+}
+
+// methodDescriptor looks up the descriptor of a full method name such as /base.v1.UserService/Create
+func methodDescriptor(fullMethod string) (protoreflect.MethodDescriptor, bool) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, false
+	}
+	md, ok := d.(protoreflect.MethodDescriptor)
+	return md, ok
+}
+
+// mutating reports whether the HTTP binding of the method changes state
+func mutating(md protoreflect.MethodDescriptor) bool {
+	opts, ok := md.Options().(*descriptorpb.MethodOptions)
+	if !ok || opts == nil {
+		return false
+	}
+	rule, ok := proto.GetExtension(opts, annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return false
+	}
+	switch rule.GetPattern().(type) {
+	case *annotations.HttpRule_Post, *annotations.HttpRule_Put, *annotations.HttpRule_Patch, *annotations.HttpRule_Delete:
+		return true
+	default:
+		return false
+	}
+}
+
+// secretResponse reports whether the responses of the method carry secrets that must not be stored
+func secretResponse(md protoreflect.MethodDescriptor) bool {
+	opts, ok := md.Options().(*descriptorpb.MethodOptions)
+	if !ok || opts == nil {
+		return false
+	}
+	secret, _ := proto.GetExtension(opts, base.E_SecretResponse).(bool)
+	return secret
+}
+
+// incomingKey returns the idempotency key of the request
+func incomingKey(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(IdempotencyKeyHeader); len(v) > 0 {
+			return strings.TrimSpace(v[0])
+		}
+	}
+	return ""
+}
+
+// subject returns the authenticated subject, empty for anonymous callers
+func subject(ctx context.Context) string {
+	if p, ok := authn.FromContext(ctx); ok {
+		return p.Subject
+	}
+	return ""
+}
+
+// digest hashes the parts separated by a newline
+func digest(parts ...string) []byte {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{'\n'})
+	}
+	return h.Sum(nil)
+}
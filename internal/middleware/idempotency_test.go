@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	memoryStorage "github.com/tolgaOzen/go-skeleton/internal/storage/memory"
+	"github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+func TestIdempotency(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	interceptor := NewIdempotency(memoryStorage.NewIdempotencyStore(db), time.Hour).UnaryServerInterceptor()
+	create := &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Create"}
+
+	calls := 0
+	handler := func(_ context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return &base.UserCreateResponse{User: &base.User{Id: uint64(calls), ExternalId: req.(*base.UserCreateRequest).GetId()}}, nil
+	}
+
+	withKey := func(key string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, key))
+	}
+
+	req := &base.UserCreateRequest{Id: "ext-1", Name: "tolga"}
+
+	first, err := interceptor(withKey("key-1"), req, create, handler)
+	assert.NoError(t, err)
+
+	// the retry replays the stored response without calling the handler
+	retry, err := interceptor(withKey("key-1"), req, create, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+	assert.True(t, proto.Equal(first.(proto.Message), retry.(proto.Message)))
+
+	// the same key with another payload is rejected
+	_, err = interceptor(withKey("key-1"), &base.UserCreateRequest{Id: "ext-2", Name: "tolga"}, create, handler)
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_MISMATCH.String(), status.Convert(err).Message())
+	assert.Equal(t, 1, calls)
+
+	// requests without key and non mutating methods are not affected
+	_, err = interceptor(context.Background(), req, create, handler)
+	assert.NoError(t, err)
+	_, err = interceptor(withKey("key-2"), &base.UserGetRequest{Id: 1}, &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Get"}, func(_ context.Context, _ interface{}) (interface{}, error) {
+		return &base.UserGetResponse{}, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotencySecretResponse(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	interceptor := NewIdempotency(memoryStorage.NewIdempotencyStore(db), time.Hour).UnaryServerInterceptor()
+	create := &grpc.UnaryServerInfo{FullMethod: "/base.v1.ApiKeyService/Create"}
+
+	calls := 0
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		calls++
+		return &base.ApiKeyCreateResponse{ApiKey: &base.ApiKey{Id: uint64(calls)}, Secret: "sk_secret"}, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, "key-1"))
+	req := &base.ApiKeyCreateRequest{Name: "ci"}
+
+	// the secret is never stored, so the retry is not replayed but handled again
+	for range 2 {
+		_, err = interceptor(ctx, req, create, handler)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotencyFailedRequest(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	interceptor := NewIdempotency(memoryStorage.NewIdempotencyStore(db), time.Hour).UnaryServerInterceptor()
+	create := &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Create"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, "key-1"))
+	req := &base.UserCreateRequest{Id: "ext-1", Name: "tolga"}
+
+	_, err = interceptor(ctx, req, create, func(_ context.Context, _ interface{}) (interface{}, error) {
+		return nil, errors.New("connection refused")
+	})
+	assert.Error(t, err)
+
+	// failed requests release the key so the retry runs the handler
+	_, err = interceptor(ctx, req, create, func(_ context.Context, _ interface{}) (interface{}, error) {
+		return &base.UserCreateResponse{User: &base.User{Id: 1}}, nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestIdempotencyEmptyResponse(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	interceptor := NewIdempotency(memoryStorage.NewIdempotencyStore(db), time.Hour).UnaryServerInterceptor()
+	create := &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Create"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, "key-1"))
+	req := &base.UserCreateRequest{Id: "ext-1", Name: "tolga"}
+
+	calls := 0
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		calls++
+		return &base.UserCreateResponse{}, nil
+	}
+
+	_, err = interceptor(ctx, req, create, handler)
+	assert.NoError(t, err)
+
+	// a response marshalling to no bytes is replayed, not taken for a request in progress
+	retry, err := interceptor(ctx, req, create, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+	assert.True(t, proto.Equal(&base.UserCreateResponse{}, retry.(proto.Message)))
+}
+
+func TestIdempotencyInProgress(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	interceptor := NewIdempotency(memoryStorage.NewIdempotencyStore(db), time.Hour).UnaryServerInterceptor()
+	create := &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Create"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, "key-1"))
+	req := &base.UserCreateRequest{Id: "ext-1", Name: "tolga"}
+
+	_, err = interceptor(ctx, req, create, func(ctx context.Context, req interface{}) (interface{}, error) {
+		// a retry arriving while the first request runs
+		_, err := interceptor(ctx, req, create, func(_ context.Context, _ interface{}) (interface{}, error) {
+			return &base.UserCreateResponse{}, nil
+		})
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		return &base.UserCreateResponse{User: &base.User{Id: 1}}, nil
+	})
+	assert.NoError(t, err)
+}
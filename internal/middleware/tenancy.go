@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/authz"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// TenantHeader carries the id of the tenant a call is made in, the gateway forwards the X-Tenant-Id HTTP header as it.
+const TenantHeader = "x-tenant-id"
+
+// CrossTenantPermission lets authenticated callers whose credentials are bound to no tenant act in any tenant.
+const CrossTenantPermission = "tenants.any"
+
+// Tenancy resolves the tenant every call is made in and stores it in the context, where the storage scopes its
+// queries by it. A tenant claim of the authenticated principal takes precedence and a header naming another
+// tenant is rejected, so callers can not leave the tenant their credentials were issued for. Authenticated callers
+// without a tenant claim can only name a tenant other than the default when their roles grant CrossTenantPermission,
+// which requires authorization to be enabled. Calls carrying neither are made in the default tenant, or rejected
+// when no default is configured. Callers that may act in any tenant are marked as operators of all tenants,
+// which the TenantService requires to list and delete tenants. The health and reflection
+// services are served without a tenant, so probes keep working when every call has to name one.
+type Tenancy struct {
+	claim         string
+	defaultTenant uint64
+	authorizer    *authz.Authorizer
+}
+
+// NewTenancy is a constructor function for Tenancy, the authorizer is nil when authorization is disabled.
+func NewTenancy(conf config.Tenancy, authorizer *authz.Authorizer) *Tenancy {
+	return &Tenancy{
+		claim:         conf.Claim,
+		defaultTenant: conf.DefaultTenant,
+		authorizer:    authorizer,
+	}
+}
+
+// UnaryServerInterceptor resolves the tenant of unary calls.
+func (t *Tenancy) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if tenantless(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := t.resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		markTenant(ctx, tenancy.ID(ctx))
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor resolves the tenant of streaming calls.
+func (t *Tenancy) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if tenantless(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, err := t.resolve(ss.Context())
+		if err != nil {
+			return err
+		}
+		markTenant(ctx, tenancy.ID(ctx))
+		wrapped := grpcMiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+// tenantless reports whether the method is served without a tenant, the health and reflection services name none.
+func tenantless(method string) bool {
+	return strings.HasPrefix(method, "/grpc.health.v1.Health/") || strings.HasPrefix(method, "/grpc.reflection.")
+}
+
+// resolve returns a copy of the context carrying the tenant of the call, taken from the claims of the principal,
+// the header or the default
+func (t *Tenancy) resolve(ctx context.Context) (context.Context, error) {
+	var header uint64
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(TenantHeader); len(v) > 0 && strings.TrimSpace(v[0]) != "" {
+			var err error
+			header, err = strconv.ParseUint(strings.TrimSpace(v[0]), 10, 64)
+			if err != nil || header == 0 {
+				return nil, apierrors.Newf(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, "%s must be a positive integer", TenantHeader)
+			}
+		}
+	}
+
+	if claimed, ok := t.claimed(ctx); ok {
+		if header != 0 && header != claimed {
+			return nil, apierrors.Newf(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, "%s does not match the tenant of the credentials", TenantHeader)
+		}
+		return tenancy.NewContext(ctx, claimed), nil
+	}
+
+	// Without authentication there are no credentials to bind callers to a tenant.
+	p, authenticated := authn.FromContext(ctx)
+	if !authenticated || t.crossTenant(p) {
+		ctx = tenancy.Operator(ctx)
+	} else if header != 0 && header != t.defaultTenant {
+		return nil, apierrors.New(base.ErrorCode_ERROR_CODE_PERMISSION_DENIED).WithMetadata("permission", CrossTenantPermission)
+	}
+
+	if header != 0 {
+		return tenancy.NewContext(ctx, header), nil
+	}
+	if t.defaultTenant != 0 {
+		return tenancy.NewContext(ctx, t.defaultTenant), nil
+	}
+	return nil, apierrors.Newf(base.ErrorCode_ERROR_CODE_MISSING_ARGUMENT, "%s is required", TenantHeader)
+}
+
+// crossTenant reports whether the roles of the principal grant CrossTenantPermission
+func (t *Tenancy) crossTenant(p authn.Principal) bool {
+	return t.authorizer != nil && t.authorizer.Missing(p.Roles, []string{CrossTenantPermission}) == ""
+}
+
+// claimed returns the tenant the credentials of the authenticated principal are bound to or its tenant claim,
+// claims may hold the id as a number or a string
+func (t *Tenancy) claimed(ctx context.Context) (uint64, bool) {
+	p, ok := authn.FromContext(ctx)
+	if !ok {
+		return 0, false
+	}
+	if p.TenantID != 0 {
+		return p.TenantID, true
+	}
+	if t.claim == "" {
+		return 0, false
+	}
+	switch v := p.Claims[t.claim].(type) {
+	case float64:
+		if v > 0 && v == float64(uint64(v)) {
+			return uint64(v), true
+		}
+	case string:
+		if id, err := strconv.ParseUint(v, 10, 64); err == nil && id != 0 {
+			return id, true
+		}
+	case fmt.Stringer:
+		if id, err := strconv.ParseUint(v.String(), 10, 64); err == nil && id != 0 {
+			return id, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/authz"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+func TestTenancy(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Get"}
+
+	resolve := func(tn *Tenancy, ctx context.Context) (uint64, error) {
+		var resolved uint64
+		_, err := tn.UnaryServerInterceptor()(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			resolved, _ = tenancy.FromContext(ctx)
+			return nil, nil
+		})
+		return resolved, err
+	}
+
+	withHeader := func(ctx context.Context, value string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(TenantHeader, value))
+	}
+
+	withClaim := func(value interface{}) context.Context {
+		return authn.NewContext(context.Background(), authn.Principal{Subject: "alice", Claims: map[string]interface{}{"tenant_id": value}})
+	}
+
+	tn := NewTenancy(config.Tenancy{Claim: "tenant_id", DefaultTenant: 1}, nil)
+
+	// calls naming no tenant are made in the default one
+	id, err := resolve(tn, context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), id)
+
+	// the header names the tenant of anonymous callers
+	id, err = resolve(tn, withHeader(context.Background(), "3"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), id)
+
+	// claims may hold the tenant as a number or a string
+	id, err = resolve(tn, withClaim(float64(4)))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), id)
+
+	id, err = resolve(tn, withClaim("5"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), id)
+
+	// a matching header is accepted, another tenant is rejected
+	id, err = resolve(tn, withHeader(withClaim("5"), "5"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), id)
+
+	_, err = resolve(tn, withHeader(withClaim("5"), "6"))
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, apierrors.FromError(err).Code)
+
+	// credentials bound to a tenant take precedence over claims, also when no claim is configured
+	bound := authn.NewContext(context.Background(), authn.Principal{Subject: "api-key:1", TenantID: 7, Claims: map[string]interface{}{"tenant_id": "5"}})
+	id, err = resolve(tn, bound)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), id)
+
+	_, err = resolve(NewTenancy(config.Tenancy{DefaultTenant: 1}, nil), withHeader(bound, "6"))
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, apierrors.FromError(err).Code)
+
+	// malformed headers are rejected
+	for _, value := range []string{"acme", "0", "-1"} {
+		_, err = resolve(tn, withHeader(context.Background(), value))
+		assert.Equal(t, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, apierrors.FromError(err).Code, value)
+	}
+
+	// without a default tenant every call has to name one
+	strict := NewTenancy(config.Tenancy{Claim: "tenant_id"}, nil)
+	_, err = resolve(strict, context.Background())
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_MISSING_ARGUMENT, apierrors.FromError(err).Code)
+
+	id, err = resolve(strict, withHeader(context.Background(), "2"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), id)
+}
+
+func TestTenancyCrossTenant(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/base.v1.UserService/Get"}
+
+	authorizer, err := authz.New(authz.Policy{Roles: []authz.Role{
+		{Name: "admin", Permissions: []string{"*"}},
+		{Name: "editor", Permissions: []string{"users.*"}},
+	}})
+	assert.NoError(t, err)
+
+	var operator bool
+	resolve := func(tn *Tenancy, principal authn.Principal, header string) (uint64, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(TenantHeader, header))
+		if principal.Subject != "" {
+			ctx = authn.NewContext(ctx, principal)
+		}
+		var resolved uint64
+		_, err := tn.UnaryServerInterceptor()(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			resolved, _ = tenancy.FromContext(ctx)
+			operator = tenancy.IsOperator(ctx)
+			return nil, nil
+		})
+		return resolved, err
+	}
+
+	admin := authn.Principal{Subject: "ops", Roles: []string{"admin"}}
+	editor := authn.Principal{Subject: "alice", Roles: []string{"editor"}}
+
+	tn := NewTenancy(config.Tenancy{Claim: "tenant_id", DefaultTenant: 1}, authorizer)
+
+	// credentials bound to no tenant can only name another tenant when granted to
+	_, err = resolve(tn, editor, "3")
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_PERMISSION_DENIED, apierrors.FromError(err).Code)
+
+	id, err := resolve(tn, admin, "3")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), id)
+	assert.True(t, operator)
+
+	// naming the default tenant grants nothing
+	id, err = resolve(tn, editor, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), id)
+	assert.False(t, operator)
+
+	// credentials bound to a tenant never act in others, whatever their roles
+	bound := authn.Principal{Subject: "api-key:1", TenantID: 2, Roles: []string{"admin"}}
+	id, err = resolve(tn, bound, "2")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), id)
+	assert.False(t, operator)
+
+	// without authentication nothing binds callers to a tenant
+	id, err = resolve(tn, authn.Principal{}, "3")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), id)
+	assert.True(t, operator)
+
+	// without authorization no role grants it
+	_, err = resolve(NewTenancy(config.Tenancy{DefaultTenant: 1}, nil), admin, "3")
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_PERMISSION_DENIED, apierrors.FromError(err).Code)
+}
+
+// tenantStream is a server stream carrying the given context
+type tenantStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tenantStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestTenancyStream(t *testing.T) {
+	interceptor := NewTenancy(config.Tenancy{DefaultTenant: 1}, nil).StreamServerInterceptor()
+
+	var resolved uint64
+	ss := &tenantStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(TenantHeader, "2"))}
+	err := interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: "/base.v1.UserService/Watch"}, func(_ interface{}, stream grpc.ServerStream) error {
+		resolved, _ = tenancy.FromContext(stream.Context())
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), resolved)
+
+	ss = &tenantStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(TenantHeader, "acme"))}
+	err = interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: "/base.v1.UserService/Watch"}, func(_ interface{}, _ grpc.ServerStream) error {
+		t.Fatal("handler called for a malformed tenant")
+		return nil
+	})
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, apierrors.FromError(err).Code)
+}
@@ -0,0 +1,40 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+)
+
+// Log - Publisher writing every event as a line of JSON, e.g. to stdout
+type Log struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewLog(w io.Writer) *Log {
+	return &Log{
+		w: w,
+	}
+}
+
+// Publish writes the event followed by a newline.
+func (l *Log) Publish(_ context.Context, event storage.UserEvent) error {
+	body, err := protojson.Marshal(event.ToProto())
+	if err != nil {
+		return fmt.Errorf("failed to encode user event: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err = fmt.Fprintf(l.w, "{\"id\":\"%d\",\"event\":%s}\n", event.ID, body); err != nil {
+		return fmt.Errorf("failed to write user event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+)
+
+// EventPublisher - Interface for delivering user events to other systems. Events may be delivered more than
+// once, receivers tell repeated deliveries apart by the event id.
+type EventPublisher interface {
+	// Publish - Deliver the event, an error leaves the event pending so it is published again later.
+	Publish(ctx context.Context, event storage.UserEvent) (err error)
+}
+
+// Noop - Publisher that drops every event, the events are only kept for watchers
+type Noop struct{}
+
+func NewNoop() *Noop {
+	return &Noop{}
+}
+
+func (n *Noop) Publish(_ context.Context, _ storage.UserEvent) error {
+	return nil
+}
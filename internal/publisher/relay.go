@@ -0,0 +1,142 @@
+package publisher
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+)
+
+// Relay - Publishes the user events recorded in the outbox and cleans up the delivered ones.
+type Relay struct {
+	outbox    storage.Outbox
+	publisher EventPublisher
+	batchSize int
+	retention time.Duration
+
+	published metric.Int64Counter
+	failures  metric.Int64Counter
+	cleaned   metric.Int64Counter
+}
+
+// NewRelay - Creates a new relay publishing up to batchSize events at a time and keeping delivered events
+// for retention, watchers can only resume within it
+func NewRelay(outbox storage.Outbox, publisher EventPublisher, batchSize int, retention time.Duration) (*Relay, error) {
+	published, err := internal.Meter.Int64Counter("user_events_published", metric.WithDescription("Number of user events published"))
+	if err != nil {
+		return nil, err
+	}
+
+	failures, err := internal.Meter.Int64Counter("user_event_publish_failures", metric.WithDescription("Number of failed user event publishes"))
+	if err != nil {
+		return nil, err
+	}
+
+	cleaned, err := internal.Meter.Int64Counter("user_events_cleaned", metric.WithDescription("Number of published user events removed"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Relay{
+		outbox:    outbox,
+		publisher: publisher,
+		batchSize: batchSize,
+		retention: retention,
+		published: published,
+		failures:  failures,
+		cleaned:   cleaned,
+	}, nil
+}
+
+// Run - Relays every interval and cleans up every cleanupInterval until the context is cancelled. A full batch
+// is followed by the next one right away, a failed publish is retried with exponential backoff up to maxBackoff.
+func (r *Relay) Run(ctx context.Context, interval, maxBackoff, cleanupInterval time.Duration) error {
+	retry := backoff.NewExponentialBackOff()
+	retry.InitialInterval = interval
+	retry.MaxInterval = maxBackoff
+	retry.MaxElapsedTime = 0
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	cleanup := time.NewTicker(cleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-cleanup.C:
+			cleaned, err := r.Cleanup(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to clean up published user events", slog.Any("error", err))
+				continue
+			}
+			if cleaned > 0 {
+				slog.InfoContext(ctx, "cleaned up published user events", slog.Int64("count", cleaned))
+			}
+		case <-timer.C:
+			published, err := r.Relay(ctx)
+			next := interval
+			switch {
+			case err != nil:
+				next = retry.NextBackOff()
+				slog.ErrorContext(ctx, "failed to publish user events", slog.Any("error", err), slog.Duration("retry_in", next))
+			case published == r.batchSize:
+				retry.Reset()
+				next = 0
+			default:
+				retry.Reset()
+			}
+			timer.Reset(next)
+		}
+	}
+}
+
+// Relay - Publishes the next batch of pending user events in order, stopping at the first failure
+func (r *Relay) Relay(ctx context.Context) (published int, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "relay.relay")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			r.failures.Add(ctx, 1)
+		}
+		span.SetAttributes(attribute.Int("published", published))
+		r.published.Add(ctx, int64(published))
+	}()
+
+	return r.outbox.Relay(ctx, r.batchSize, func(event storage.UserEvent) error {
+		return r.publisher.Publish(ctx, event)
+	})
+}
+
+// Cleanup - Removes the user events published longer than the retention period ago
+func (r *Relay) Cleanup(ctx context.Context) (cleaned int64, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "relay.cleanup")
+	defer span.End()
+
+	before := time.Now().Add(-r.retention).UTC()
+	span.SetAttributes(attribute.String("before", before.Format(time.RFC3339)))
+
+	cleaned, err = r.outbox.Cleanup(ctx, before)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int64("cleaned", cleaned))
+	r.cleaned.Add(ctx, cleaned)
+
+	return cleaned, nil
+}
@@ -0,0 +1,103 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	memoryStorage "github.com/tolgaOzen/go-skeleton/internal/storage/memory"
+	"github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+)
+
+// flaky fails every publish of the event with the given id once.
+type flaky struct {
+	failing   uint64
+	published []uint64
+}
+
+func (f *flaky) Publish(_ context.Context, event storage.UserEvent) error {
+	if event.ID == f.failing {
+		f.failing = 0
+		return errors.New("unavailable")
+	}
+	f.published = append(f.published, event.ID)
+	return nil
+}
+
+func TestRelay(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.NoError(t, memoryStorage.Seed(db))
+
+	ctx := context.Background()
+	writer := memoryStorage.NewDataWriter(db)
+	for _, id := range []string{"a", "b", "c"} {
+		_, err = writer.Write(ctx, id, id)
+		assert.NoError(t, err)
+	}
+
+	pub := &flaky{failing: 2}
+	relay, err := NewRelay(memoryStorage.NewOutbox(db), pub, 2, time.Hour)
+	assert.NoError(t, err)
+
+	// the failing event stops the relay, the events before it stay published
+	published, err := relay.Relay(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, 1, published)
+
+	// the failed event is retried first, in order
+	published, err = relay.Relay(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, published)
+
+	published, err = relay.Relay(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, published)
+	assert.Equal(t, []uint64{1, 2, 3}, pub.published)
+
+	// published events are kept for the retention period
+	cleaned, err := relay.Cleanup(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), cleaned)
+
+	// the latest event is kept so up to date watchers can resume
+	relay.retention = -time.Hour
+	cleaned, err = relay.Cleanup(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), cleaned)
+}
+
+func TestLog(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.NoError(t, memoryStorage.Seed(db))
+
+	ctx := context.Background()
+	writer := memoryStorage.NewDataWriter(db)
+	_, err = writer.Write(ctx, "a", "tolga")
+	assert.NoError(t, err)
+	_, err = writer.Update(ctx, 1, "ozen", 0)
+	assert.NoError(t, err)
+
+	var out bytes.Buffer
+	relay, err := NewRelay(memoryStorage.NewOutbox(db), NewLog(&out), 10, time.Hour)
+	assert.NoError(t, err)
+
+	published, err := relay.Relay(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, published)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"id":"1"`)
+	assert.Contains(t, lines[0], `"TYPE_CREATED"`)
+	assert.Contains(t, lines[1], `"ozen"`)
+}
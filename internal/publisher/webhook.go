@@ -0,0 +1,82 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+)
+
+const (
+	// EventIDHeader carries the id of the delivered event, it is the same for every delivery of an event.
+	EventIDHeader = "X-Event-Id"
+	// EventTypeHeader carries the type of the delivered event.
+	EventTypeHeader = "X-Event-Type"
+	// SignatureHeader carries the HMAC-SHA256 of the body keyed with the webhook secret, as "sha256=<hex>".
+	SignatureHeader = "X-Signature"
+)
+
+// Webhook - Publisher posting every event as JSON to an HTTP endpoint
+type Webhook struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// NewWebhook - Creates a webhook publisher, bodies are signed when a secret is given
+func NewWebhook(url, secret string, timeout time.Duration) *Webhook {
+	return &Webhook{
+		url:    url,
+		secret: []byte(secret),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Publish posts the event, any response other than 2xx fails the delivery.
+func (h *Webhook) Publish(ctx context.Context, event storage.UserEvent) error {
+	body, err := protojson.Marshal(event.ToProto())
+	if err != nil {
+		return fmt.Errorf("failed to encode user event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, strconv.FormatUint(event.ID, 10))
+	req.Header.Set(EventTypeHeader, string(event.Type))
+	if len(h.secret) > 0 {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(h.secret, body))
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign - Hex encoded HMAC-SHA256 of the body, receivers compare it to the signature header
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,57 @@
+package publisher
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+func TestWebhook(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	event := storage.UserEvent{
+		ID:        7,
+		Type:      storage.UserUpdated,
+		User:      &base.User{Id: 1, Name: "tolga"},
+		CreatedAt: time.Now(),
+	}
+
+	webhook := NewWebhook(server.URL, "secret", time.Second)
+	assert.NoError(t, webhook.Publish(context.Background(), event))
+
+	assert.Equal(t, http.MethodPost, got.Method)
+	assert.Equal(t, "application/json", got.Header.Get("Content-Type"))
+	assert.Equal(t, "7", got.Header.Get(EventIDHeader))
+	assert.Equal(t, "updated", got.Header.Get(EventTypeHeader))
+	assert.Equal(t, "sha256="+Sign([]byte("secret"), body), got.Header.Get(SignatureHeader))
+
+	var published base.UserEvent
+	assert.NoError(t, protojson.Unmarshal(body, &published))
+	assert.Equal(t, base.UserEvent_TYPE_UPDATED, published.GetType())
+	assert.Equal(t, "tolga", published.GetUser().GetName())
+
+	// unsigned without a secret
+	assert.NoError(t, NewWebhook(server.URL, "", time.Second).Publish(context.Background(), event))
+	assert.Empty(t, got.Header.Get(SignatureHeader))
+
+	// anything but 2xx fails the delivery
+	status = http.StatusInternalServerError
+	assert.Error(t, webhook.Publish(context.Background(), event))
+}
@@ -0,0 +1,208 @@
+package servers
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/authn/apikey"
+	"github.com/tolgaOzen/go-skeleton/internal/authz"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	v1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+	"github.com/tolgaOzen/go-skeleton/pkg/token"
+)
+
+// ApiKeyServer - Structure for Api Key Server
+type ApiKeyServer struct {
+	v1.UnimplementedApiKeyServiceServer
+
+	store      storage.ApiKeyStore
+	signer     *token.Signer
+	authorizer *authz.Authorizer
+	keys       *apikey.KeyAuthn
+}
+
+// NewApiKeyServer - Creates new Api Key Server, callers can only hand out the permissions they hold themselves
+// when an authorizer is given. Revoked and rotated keys are evicted from the cache of the authenticator, when
+// callers authenticate with API keys, so they stop working at once on this instance
+func NewApiKeyServer(store storage.ApiKeyStore, signer *token.Signer, authorizer *authz.Authorizer, keys *apikey.KeyAuthn) *ApiKeyServer {
+	return &ApiKeyServer{
+		store:      store,
+		signer:     signer,
+		authorizer: authorizer,
+		keys:       keys,
+	}
+}
+
+// Create - Mint new Api Key
+func (a *ApiKeyServer) Create(ctx context.Context, request *v1.ApiKeyCreateRequest) (*v1.ApiKeyCreateResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "api-key.create")
+	defer span.End()
+
+	secret, err := a.mint(ctx, request.GetRoles())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	key := storage.ApiKey{
+		Name:   request.GetName(),
+		Prefix: secret.Prefix,
+		Hash:   secret.Hash,
+		Roles:  request.GetRoles(),
+	}
+	if request.GetExpiresAt() != nil {
+		key.ExpiresAt = request.GetExpiresAt().AsTime()
+	}
+
+	created, err := a.store.Create(ctx, key)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	return &v1.ApiKeyCreateResponse{
+		ApiKey: created,
+		Secret: secret.Value,
+	}, nil
+}
+
+// List - List Api Keys
+func (a *ApiKeyServer) List(ctx context.Context, request *v1.ApiKeyListRequest) (*v1.ApiKeyListResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "api-key.list")
+	defer span.End()
+
+	opts := []database.PaginationOption{
+		database.Size(request.GetSize()),
+		database.OrderBy(storage.ApiKeyOrder),
+	}
+
+	if request.GetPageToken() != "" {
+		value, err := a.signer.Verify(request.GetPageToken())
+		if err != nil {
+			err = apierrors.New(v1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			slog.ErrorContext(ctx, err.Error())
+			return nil, apierrors.FromError(err)
+		}
+		opts = append(opts, database.Token(value))
+	}
+
+	keys, ct, err := a.store.List(ctx, database.NewPagination(opts...))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	return &v1.ApiKeyListResponse{
+		ApiKeys:       keys,
+		NextPageToken: a.signer.Sign(ct.String()),
+	}, nil
+}
+
+// Revoke - Revoke Api Key
+func (a *ApiKeyServer) Revoke(ctx context.Context, request *v1.ApiKeyRevokeRequest) (*v1.ApiKeyRevokeResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "api-key.revoke")
+	defer span.End()
+
+	existing, err := a.store.Get(ctx, request.GetId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	revoked, err := a.store.Revoke(ctx, request.GetId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+	a.forget(existing.Hash)
+
+	return &v1.ApiKeyRevokeResponse{
+		ApiKey: revoked,
+	}, nil
+}
+
+// Rotate - Replace Api Key with a new secret, revoking the old one
+func (a *ApiKeyServer) Rotate(ctx context.Context, request *v1.ApiKeyRotateRequest) (*v1.ApiKeyRotateResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "api-key.rotate")
+	defer span.End()
+
+	// The new secret carries the roles of the key, so rotating it hands them out just like minting does.
+	existing, err := a.store.Get(ctx, request.GetId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	secret, err := a.mint(ctx, existing.Roles)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	replacement := storage.ApiKey{
+		Prefix: secret.Prefix,
+		Hash:   secret.Hash,
+	}
+	if request.GetExpiresAt() != nil {
+		replacement.ExpiresAt = request.GetExpiresAt().AsTime()
+	}
+
+	created, err := a.store.Rotate(ctx, request.GetId(), replacement)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+	a.forget(existing.Hash)
+
+	return &v1.ApiKeyRotateResponse{
+		ApiKey: created,
+		Secret: secret.Value,
+	}, nil
+}
+
+// forget - Evicts the revoked key from the cache of the authenticator, once the revocation is stored
+func (a *ApiKeyServer) forget(hash string) {
+	if a.keys != nil {
+		a.keys.Forget(hash)
+	}
+}
+
+// mint - Generates the secret of a key granting the roles, once the caller is known to hold their permissions
+func (a *ApiKeyServer) mint(ctx context.Context, roles []string) (apikey.Secret, error) {
+	if a.authorizer != nil {
+		principal, _ := authn.FromContext(ctx)
+		if exceeding := a.authorizer.Exceeding(principal.Roles, roles); exceeding != "" {
+			return apikey.Secret{}, apierrors.New(v1.ErrorCode_ERROR_CODE_PERMISSION_DENIED).WithMetadata("permission", exceeding)
+		}
+	}
+
+	secret, err := apikey.NewSecret()
+	if err != nil {
+		return apikey.Secret{}, apierrors.Wrap(v1.ErrorCode_ERROR_CODE_INTERNAL, err)
+	}
+	return secret, nil
+}
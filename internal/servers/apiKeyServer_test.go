@@ -0,0 +1,60 @@
+package servers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/tolgaOzen/go-skeleton/internal/authn/apikey"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	memoryStorage "github.com/tolgaOzen/go-skeleton/internal/storage/memory"
+	"github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	v1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+	"github.com/tolgaOzen/go-skeleton/pkg/token"
+)
+
+func TestApiKeyServerEvictsRevokedKeys(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.NoError(t, memoryStorage.Seed(db))
+
+	store := memoryStorage.NewApiKeyStore(db)
+	keys, err := apikey.NewKeyAuthn(store, config.ApiKey{CacheTTL: time.Hour, CacheEntries: 100}, nil)
+	assert.NoError(t, err)
+	defer keys.Close()
+
+	s := NewApiKeyServer(store, token.NewSigner([]byte("secret")), nil, keys)
+	ctx := context.Background()
+
+	authenticate := func(secret string) error {
+		md := metadata.Pairs("authorization", "Bearer "+secret)
+		_, err := keys.Authenticate(metadata.NewIncomingContext(ctx, md))
+		keys.Wait()
+		return err
+	}
+
+	// a cached key is rejected as soon as it is revoked
+	created, err := s.Create(ctx, &v1.ApiKeyCreateRequest{Name: "ci"})
+	assert.NoError(t, err)
+	assert.NoError(t, authenticate(created.GetSecret()))
+
+	_, err = s.Revoke(ctx, &v1.ApiKeyRevokeRequest{Id: created.GetApiKey().GetId()})
+	assert.NoError(t, err)
+	assert.Equal(t, codes.Unauthenticated, status.Code(authenticate(created.GetSecret())))
+
+	// so is the secret a rotation replaces
+	created, err = s.Create(ctx, &v1.ApiKeyCreateRequest{Name: "cd"})
+	assert.NoError(t, err)
+	assert.NoError(t, authenticate(created.GetSecret()))
+
+	rotated, err := s.Rotate(ctx, &v1.ApiKeyRotateRequest{Id: created.GetApiKey().GetId()})
+	assert.NoError(t, err)
+	assert.Equal(t, codes.Unauthenticated, status.Code(authenticate(created.GetSecret())))
+	assert.NoError(t, authenticate(rotated.GetSecret()))
+}
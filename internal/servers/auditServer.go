@@ -0,0 +1,82 @@
+package servers
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	v1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+	"github.com/tolgaOzen/go-skeleton/pkg/token"
+)
+
+// AuditServer - Structure for Audit Server
+type AuditServer struct {
+	v1.UnimplementedAuditServiceServer
+
+	store  storage.AuditStore
+	signer *token.Signer
+}
+
+// NewAuditServer - Creates new Audit Server
+func NewAuditServer(store storage.AuditStore, signer *token.Signer) *AuditServer {
+	return &AuditServer{
+		store:  store,
+		signer: signer,
+	}
+}
+
+// ListAuditEvents - List Audit Events, latest first
+func (t *AuditServer) ListAuditEvents(ctx context.Context, request *v1.AuditEventListRequest) (*v1.AuditEventListResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "audit.list-audit-events")
+	defer span.End()
+
+	opts := []database.PaginationOption{
+		database.Size(request.GetSize()),
+		database.OrderBy(storage.AuditOrder),
+	}
+
+	filter := storage.AuditFilter{
+		Actor: request.GetActor(),
+	}
+	if request.GetStartTime() != nil {
+		filter.After = request.GetStartTime().AsTime()
+	}
+	if request.GetEndTime() != nil {
+		filter.Before = request.GetEndTime().AsTime()
+	}
+
+	if request.GetPageToken() != "" {
+		value, err := t.signer.Verify(request.GetPageToken())
+		if err != nil {
+			err = apierrors.New(v1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			slog.ErrorContext(ctx, err.Error())
+			return nil, apierrors.FromError(err)
+		}
+		opts = append(opts, database.Token(value))
+	}
+
+	events, ct, err := t.store.List(ctx, filter, database.NewPagination(opts...))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	response := &v1.AuditEventListResponse{
+		Events:        make([]*v1.AuditEvent, 0, len(events)),
+		NextPageToken: t.signer.Sign(ct.String()),
+	}
+	for _, event := range events {
+		response.Events = append(response.Events, event.ToProto())
+	}
+
+	return response, nil
+}
@@ -1,35 +1,71 @@
 package servers
 
 import (
-	"google.golang.org/grpc/codes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc/status"
 
-	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+	"github.com/tolgaOzen/go-skeleton/internal/middleware"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
 )
 
-// GetStatus - Get status code and message from error
-func GetStatus(err error) codes.Code {
-	s, ok := status.FromError(err)
-	if ok {
-		// This was a custom error, so return its code directly
-		return s.Code()
+// ErrorHandler - Renders gateway errors as an ErrorResponse {code, message, details} with the
+// HTTP status matching the gRPC code, so HTTP clients can switch on the same stable codes.
+func ErrorHandler(ctx context.Context, _ *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	st := status.Convert(err)
+	e := apierrors.FromStatus(st)
+
+	// Forward the response headers set by the handler, e.g. rate limit hints.
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		for k, vs := range md.HeaderMD {
+			h, ok := OutgoingHeaderMatcher(k)
+			if !ok {
+				continue
+			}
+			for _, v := range vs {
+				w.Header().Add(h, v)
+			}
+		}
 	}
 
-	// If this wasn't a custom error, continue with your existing logic...
-	code, ok := base.ErrorCode_value[err.Error()]
-	if !ok {
-		return codes.Internal
+	resp := e.Response()
+
+	buf, merr := marshaler.Marshal(resp)
+	if merr != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"code": "ERROR_CODE_SERIALIZATION", "message": "failed to marshal error response"}`))
+		return
 	}
-	switch {
-	case code > 999 && code < 1999:
-		return codes.Unauthenticated
-	case code > 1999 && code < 2999:
-		return codes.InvalidArgument
-	case code > 3999 && code < 4999:
-		return codes.NotFound
-	case code > 4999 && code < 5999:
-		return codes.Internal
-	default:
-		return codes.Internal
+
+	w.Header().Set("Content-Type", marshaler.ContentType(resp))
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	_, _ = w.Write(buf)
+}
+
+// OutgoingHeaderMatcher - Forwards the rate limit, idempotency and entity tag headers under their standard HTTP names,
+// other metadata keeps the gateway's default prefix.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case middleware.RetryAfterHeader, middleware.RateLimitLimitHeader, middleware.RateLimitRemainingHeader, middleware.RateLimitResetHeader,
+		middleware.IdempotentReplayedHeader:
+		return http.CanonicalHeaderKey(key), true
+	case ETagHeader:
+		return "ETag", true
+	}
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
+// IncomingHeaderMatcher - Forwards the Idempotency-Key and If-Match headers to the gRPC metadata,
+// other headers follow the gateway's default rules.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	switch k := strings.ToLower(key); k {
+	case middleware.IdempotencyKeyHeader, middleware.TenantHeader, IfMatchHeader:
+		return k, true
 	}
+	return runtime.DefaultHeaderMatcher(key)
 }
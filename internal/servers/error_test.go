@@ -0,0 +1,50 @@
+package servers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+func TestErrorHandler(t *testing.T) {
+	marshaler := &runtime.JSONPb{MarshalOptions: protojson.MarshalOptions{UseProtoNames: true}}
+
+	rec := httptest.NewRecorder()
+	err := apierrors.New(base.ErrorCode_ERROR_CODE_NOT_FOUND).WithMessage("user not found").WithMetadata("id", "7")
+	ErrorHandler(context.Background(), nil, marshaler, rec, httptest.NewRequest(http.MethodGet, "/v1/users/7", nil), err)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "ERROR_CODE_NOT_FOUND", body["code"])
+	assert.Equal(t, "user not found", body["message"])
+	assert.Equal(t, map[string]interface{}{"id": "7"}, body["details"])
+}
+
+func TestHeaderMatchers(t *testing.T) {
+	h, ok := OutgoingHeaderMatcher(ETagHeader)
+	assert.True(t, ok)
+	assert.Equal(t, "ETag", h)
+
+	h, ok = OutgoingHeaderMatcher("x-custom")
+	assert.True(t, ok)
+	assert.Equal(t, runtime.MetadataHeaderPrefix+"x-custom", h)
+
+	h, ok = IncomingHeaderMatcher("If-Match")
+	assert.True(t, ok)
+	assert.Equal(t, IfMatchHeader, h)
+
+	h, ok = IncomingHeaderMatcher("Idempotency-Key")
+	assert.True(t, ok)
+	assert.Equal(t, "idempotency-key", h)
+}
@@ -2,31 +2,102 @@ package servers
 
 import (
 	"context"
+	"log/slog"
+	"sync"
+	"time"
 
-	"google.golang.org/grpc/codes"
+	"github.com/sony/gobreaker"
+	grpcHealth "google.golang.org/grpc/health"
 	health "google.golang.org/grpc/health/grpc_health_v1"
-	"google.golang.org/grpc/status"
+
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
 )
 
 // HealthServer - Structure for Health Server
+// Serving statuses are derived from periodic datastore readiness probes and,
+// when enabled, the state of the storage circuit breaker.
 type HealthServer struct {
-	health.UnimplementedHealthServer
+	*grpcHealth.Server
+
+	db       database.Database
+	cb       *gobreaker.CircuitBreaker
+	services []string
+	interval time.Duration
+
+	mu     sync.Mutex
+	status health.HealthCheckResponse_ServingStatus
 }
 
 // NewHealthServer - Creates new HealthServer Server
-func NewHealthServer() *HealthServer {
-	return &HealthServer{}
+// Every given service name, together with the overall "" service, reports NOT_SERVING until the first probe succeeds.
+func NewHealthServer(db database.Database, cb *gobreaker.CircuitBreaker, interval time.Duration, services ...string) *HealthServer {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	s := &HealthServer{
+		Server:   grpcHealth.NewServer(),
+		db:       db,
+		cb:       cb,
+		services: append([]string{""}, services...),
+		interval: interval,
+		status:   health.HealthCheckResponse_NOT_SERVING,
+	}
+	for _, service := range s.services {
+		s.SetServingStatus(service, health.HealthCheckResponse_NOT_SERVING)
+	}
+	return s
+}
+
+// Run - Probes the datastore every interval until the context is canceled,
+// then marks all services as NOT_SERVING so watchers can drain traffic.
+func (s *HealthServer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.update(s.probe(ctx))
+
+		select {
+		case <-ctx.Done():
+			s.Shutdown()
+			return nil
+		case <-ticker.C:
+		}
+	}
 }
 
-// Check - Return health check status response
-func (s *HealthServer) Check(_ context.Context, _ *health.HealthCheckRequest) (*health.HealthCheckResponse, error) {
-	return &health.HealthCheckResponse{Status: health.HealthCheckResponse_SERVING}, nil
+// probe - Checks the datastore readiness and the circuit breaker state
+func (s *HealthServer) probe(ctx context.Context) health.HealthCheckResponse_ServingStatus {
+	if s.cb != nil && s.cb.State() == gobreaker.StateOpen {
+		return health.HealthCheckResponse_NOT_SERVING
+	}
+
+	ready, err := s.db.IsReady(ctx)
+	if err != nil || !ready {
+		if ctx.Err() == nil {
+			slog.WarnContext(ctx, "datastore is not ready", slog.Any("error", err))
+		}
+		return health.HealthCheckResponse_NOT_SERVING
+	}
+
+	return health.HealthCheckResponse_SERVING
 }
 
-// Watch - TO:DO
-func (s *HealthServer) Watch(_ *health.HealthCheckRequest, _ health.Health_WatchServer) error {
-	// Example of how to register both methods but only implement the Check method.
-	return status.Error(codes.Unimplemented, "unimplemented")
+// update - Propagates a status transition to every service, watchers are notified by the underlying server
+func (s *HealthServer) update(status health.HealthCheckResponse_ServingStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.status == status {
+		return
+	}
+
+	slog.Info("health status changed", slog.String("from", s.status.String()), slog.String("to", status.String()))
+	s.status = status
+
+	for _, service := range s.services {
+		s.SetServingStatus(service, status)
+	}
 }
 
 // AuthFuncOverride is called instead of authn.
@@ -0,0 +1,46 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	health "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+)
+
+func TestHealthServer(t *testing.T) {
+	db := new(memory.MockIMDatabase)
+	db.On("IsReady", mock.Anything).Return(false, errors.New("connection refused")).Once()
+	db.On("IsReady", mock.Anything).Return(true, nil)
+
+	s := NewHealthServer(db, nil, time.Hour, "base.v1.UserService")
+	ctx := context.Background()
+
+	check := func(service string) health.HealthCheckResponse_ServingStatus {
+		resp, err := s.Check(ctx, &health.HealthCheckRequest{Service: service})
+		assert.NoError(t, err)
+		return resp.GetStatus()
+	}
+
+	// not serving until the first successful probe
+	assert.Equal(t, health.HealthCheckResponse_NOT_SERVING, check(""))
+
+	s.update(s.probe(ctx))
+	assert.Equal(t, health.HealthCheckResponse_NOT_SERVING, check(""))
+	assert.Equal(t, health.HealthCheckResponse_NOT_SERVING, check("base.v1.UserService"))
+
+	s.update(s.probe(ctx))
+	assert.Equal(t, health.HealthCheckResponse_SERVING, check(""))
+	assert.Equal(t, health.HealthCheckResponse_SERVING, check("base.v1.UserService"))
+
+	// stopping the probes drains every service
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.NoError(t, s.Run(cancelled))
+	assert.Equal(t, health.HealthCheckResponse_NOT_SERVING, check(""))
+}
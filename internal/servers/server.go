@@ -25,11 +25,16 @@ import (
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/tolgaOzen/go-skeleton/internal/authn"
+	"github.com/tolgaOzen/go-skeleton/internal/authn/apikey"
+	"github.com/tolgaOzen/go-skeleton/internal/authn/oidc"
 	"github.com/tolgaOzen/go-skeleton/internal/authn/preshared"
+	"github.com/tolgaOzen/go-skeleton/internal/authz"
 	"github.com/tolgaOzen/go-skeleton/internal/config"
 	"github.com/tolgaOzen/go-skeleton/internal/middleware"
 	"github.com/tolgaOzen/go-skeleton/internal/storage"
 	grpcV1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+	"github.com/tolgaOzen/go-skeleton/pkg/token"
 )
 
 // Container is a struct that holds the invoker and various storage
@@ -40,12 +45,55 @@ type Container struct {
 	DR storage.DataReader
 	// DataWriter for writing data to storage
 	DW storage.DataWriter
+	// Watcher for following the changes made to users
+	Watcher storage.Watcher
+	// AuditStore for reading the audit trail
+	AuditStore storage.AuditStore
+	// TenantStore for managing tenants
+	TenantStore storage.TenantStore
+	// ApiKeyStore for managing the API keys callers authenticate with
+	ApiKeyStore storage.ApiKeyStore
+	// Signer for signing page tokens
+	Signer *token.Signer
+	// Health server reporting datastore readiness
+	Health *HealthServer
+	// Idempotency for replaying retried mutating requests, nil when disabled
+	Idempotency *middleware.Idempotency
+	// Audit for recording mutating calls, nil when disabled
+	Audit *middleware.Audit
+	// Tenancy for resolving the tenant calls are made in
+	Tenancy *middleware.Tenancy
+	// Authorization for checking the permissions of callers, nil when disabled
+	Authorization *middleware.Authorization
 }
 
-func NewContainer(dr storage.DataReader, dw storage.DataWriter) *Container {
+func NewContainer(
+	dr storage.DataReader,
+	dw storage.DataWriter,
+	watcher storage.Watcher,
+	auditStore storage.AuditStore,
+	tenantStore storage.TenantStore,
+	apiKeyStore storage.ApiKeyStore,
+	signer *token.Signer,
+	health *HealthServer,
+	idempotency *middleware.Idempotency,
+	audit *middleware.Audit,
+	tenancy *middleware.Tenancy,
+	authorization *middleware.Authorization,
+) *Container {
 	return &Container{
-		DR: dr,
-		DW: dw,
+		DR:            dr,
+		DW:            dw,
+		Watcher:       watcher,
+		AuditStore:    auditStore,
+		TenantStore:   tenantStore,
+		ApiKeyStore:   apiKeyStore,
+		Signer:        signer,
+		Health:        health,
+		Idempotency:   idempotency,
+		Audit:         audit,
+		Tenancy:       tenancy,
+		Authorization: authorization,
 	}
 }
 
@@ -80,19 +128,77 @@ func (s *Container) Run(
 
 	// Configure authentication based on the provided method.
 	// Add the appropriate interceptors to the unary and streaming interceptors.
+	// The API key authenticator, when used, forgets the keys the ApiKeyService revokes.
+	var keyAuthn *apikey.KeyAuthn
 	if authentication != nil && authentication.Enabled {
+		var authenticator authn.Authenticator
 		switch authentication.Method {
 		case "preshared":
-			var authenticator *preshared.KeyAuthn
-			authenticator, err = preshared.NewKeyAuthn(ctx, authentication.Preshared)
+			authenticator, err = presharedAuthn(ctx, authentication.Preshared)
 			if err != nil {
 				return err
 			}
-			unaryInterceptors = append(unaryInterceptors, grpcAuth.UnaryServerInterceptor(middleware.AuthFunc(authenticator)))
-			streamingInterceptors = append(streamingInterceptors, grpcAuth.StreamServerInterceptor(middleware.AuthFunc(authenticator)))
+		case "oidc":
+			authenticator, err = oidc.NewOidcAuthn(ctx, authentication.Oidc)
+			if err != nil {
+				return err
+			}
+		case "apikey":
+			// Configured preshared keys are accepted next to the API keys, e.g. to mint the first ones
+			var fallback authn.Authenticator
+			if len(authentication.Preshared.Keys)+len(authentication.Preshared.RawKeys) > 0 {
+				fallback, err = presharedAuthn(ctx, authentication.Preshared)
+				if err != nil {
+					return err
+				}
+			}
+			keyAuthn, err = apikey.NewKeyAuthn(s.ApiKeyStore, authentication.ApiKey, fallback)
+			if err != nil {
+				return err
+			}
+			defer keyAuthn.Close()
+			authenticator = keyAuthn
 		default:
 			return fmt.Errorf("unknown authentication method: '%s'", authentication.Method)
 		}
+		unaryInterceptors = append(unaryInterceptors, grpcAuth.UnaryServerInterceptor(middleware.AuthFunc(authenticator)))
+		streamingInterceptors = append(streamingInterceptors, grpcAuth.StreamServerInterceptor(middleware.AuthFunc(authenticator)))
+	}
+
+	// Calls are audited once authenticated so the trail names the verified subject, calls rejected by
+	// authorization, tenancy or the client limiter are recorded as well and replayed idempotent calls are
+	// recorded as replays.
+	if s.Audit != nil {
+		unaryInterceptors = append(unaryInterceptors, s.Audit.UnaryServerInterceptor())
+		streamingInterceptors = append(streamingInterceptors, s.Audit.StreamServerInterceptor())
+	}
+
+	// Calls are authorized once authenticated, against the roles of the verified principal.
+	if s.Authorization != nil {
+		unaryInterceptors = append(unaryInterceptors, s.Authorization.UnaryServerInterceptor())
+		streamingInterceptors = append(streamingInterceptors, s.Authorization.StreamServerInterceptor())
+	}
+
+	// The tenant is resolved once authenticated so a tenant claim of the verified principal takes precedence.
+	if s.Tenancy != nil {
+		unaryInterceptors = append(unaryInterceptors, s.Tenancy.UnaryServerInterceptor())
+		streamingInterceptors = append(streamingInterceptors, s.Tenancy.StreamServerInterceptor())
+	}
+
+	// Clients are limited after authentication so buckets can be keyed by the verified subject.
+	if srv.ClientRateLimit.Enabled {
+		var clientLimiter *middleware.ClientRateLimiter
+		clientLimiter, err = middleware.NewClientRateLimiter(srv.ClientRateLimit)
+		if err != nil {
+			return err
+		}
+		unaryInterceptors = append(unaryInterceptors, middleware.UnaryServerInterceptor(clientLimiter))
+		streamingInterceptors = append(streamingInterceptors, middleware.StreamServerInterceptor(clientLimiter))
+	}
+
+	// Idempotency runs last so rejected requests never reserve keys and keys are scoped by the verified subject.
+	if s.Idempotency != nil {
+		unaryInterceptors = append(unaryInterceptors, s.Idempotency.UnaryServerInterceptor())
 	}
 
 	opts := []grpc.ServerOption{
@@ -114,10 +220,19 @@ func (s *Container) Run(
 	grpcServer := grpc.NewServer(opts...)
 
 	// Register various gRPC services to the server.
-	grpcV1.RegisterUserServiceServer(grpcServer, NewUserServer(s.DR, s.DW))
+	grpcV1.RegisterUserServiceServer(grpcServer, NewUserServer(s.DR, s.DW, s.Watcher, s.Signer))
+	grpcV1.RegisterAuditServiceServer(grpcServer, NewAuditServer(s.AuditStore, s.Signer))
+	grpcV1.RegisterTenantServiceServer(grpcServer, NewTenantServer(s.TenantStore, s.Signer))
+
+	// Minted keys can only grant the permissions of their minter while calls are authorized
+	var authorizer *authz.Authorizer
+	if s.Authorization != nil {
+		authorizer = s.Authorization.Authorizer()
+	}
+	grpcV1.RegisterApiKeyServiceServer(grpcServer, NewApiKeyServer(s.ApiKeyStore, s.Signer, authorizer, keyAuthn))
 
 	// Register health check and reflection services for gRPC.
-	health.RegisterHealthServer(grpcServer, NewHealthServer())
+	health.RegisterHealthServer(grpcServer, s.Health)
 	reflection.Register(grpcServer)
 
 	// If profiling is enabled, set up the profiler using the net/http package.
@@ -176,7 +291,7 @@ func (s *Container) Run(
 	if srv.HTTP.Enabled {
 		options := []grpc.DialOption{
 			grpc.WithBlock(),
-			grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		}
 		if srv.GRPC.TLSConfig.Enabled {
 			c, err := credentials.NewClientTLSFromFile(srv.GRPC.TLSConfig.CertPath, srv.NameOverride)
@@ -204,6 +319,9 @@ func (s *Container) Run(
 		healthClient := health.NewHealthClient(conn)
 		muxOpts := []runtime.ServeMuxOption{
 			runtime.WithHealthzEndpoint(healthClient),
+			runtime.WithErrorHandler(ErrorHandler),
+			runtime.WithOutgoingHeaderMatcher(OutgoingHeaderMatcher),
+			runtime.WithIncomingHeaderMatcher(IncomingHeaderMatcher),
 			runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
 				Marshaler: &runtime.JSONPb{
 					MarshalOptions: protojson.MarshalOptions{
@@ -219,10 +337,40 @@ func (s *Container) Run(
 
 		mux := runtime.NewServeMux(muxOpts...)
 
+		// Liveness only tells that the process is able to serve HTTP, readiness
+		// additionally requires the datastore to be reachable.
+		if err = mux.HandlePath(http.MethodGet, "/livez", func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+			w.WriteHeader(http.StatusOK)
+		}); err != nil {
+			return err
+		}
+		if err = mux.HandlePath(http.MethodGet, "/readyz", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			resp, err := healthClient.Check(r.Context(), &health.HealthCheckRequest{})
+			if err != nil || resp.GetStatus() != health.HealthCheckResponse_SERVING {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}); err != nil {
+			return err
+		}
+
 		if err = grpcV1.RegisterUserServiceHandler(ctx, mux, conn); err != nil {
 			return err
 		}
 
+		if err = grpcV1.RegisterAuditServiceHandler(ctx, mux, conn); err != nil {
+			return err
+		}
+
+		if err = grpcV1.RegisterTenantServiceHandler(ctx, mux, conn); err != nil {
+			return err
+		}
+
+		if err = grpcV1.RegisterApiKeyServiceHandler(ctx, mux, conn); err != nil {
+			return err
+		}
+
 		httpServer = &http.Server{
 			Addr: ":" + srv.HTTP.Port,
 			Handler: cors.New(cors.Options{
@@ -275,6 +423,23 @@ func (s *Container) Run(
 	return nil
 }
 
+// presharedAuthn creates the preshared key authenticator. Keys are rotated by editing the config file, changes
+// leaving them invalid keep the current keys.
+func presharedAuthn(ctx context.Context, keys config.Preshared) (*preshared.KeyAuthn, error) {
+	keyAuthn, err := preshared.NewKeyAuthn(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	config.Watch(func(cfg *config.Config) {
+		if err := keyAuthn.Reload(cfg.Authn.Preshared); err != nil {
+			slog.Error("failed to reload preshared keys", slog.Any("error", err))
+			return
+		}
+		slog.Info("reloaded preshared keys", slog.Int("keys", len(cfg.Authn.Preshared.Keys)+len(cfg.Authn.Preshared.RawKeys)))
+	})
+	return keyAuthn, nil
+}
+
 // InterceptorLogger adapts slog logger to interceptor logger.
 func InterceptorLogger(l *slog.Logger) logging.Logger {
 	return logging.LoggerFunc(func(ctx context.Context, lvl logging.Level, msg string, fields ...any) {
@@ -0,0 +1,129 @@
+package servers
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/middleware"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	v1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+	"github.com/tolgaOzen/go-skeleton/pkg/token"
+)
+
+// TenantServer - Structure for Tenant Server
+type TenantServer struct {
+	v1.UnimplementedTenantServiceServer
+
+	store  storage.TenantStore
+	signer *token.Signer
+}
+
+// NewTenantServer - Creates new Tenant Server
+func NewTenantServer(store storage.TenantStore, signer *token.Signer) *TenantServer {
+	return &TenantServer{
+		store:  store,
+		signer: signer,
+	}
+}
+
+// Create - Create new Tenant, reserved to callers who may act in any tenant
+func (t *TenantServer) Create(ctx context.Context, request *v1.TenantCreateRequest) (*v1.TenantCreateResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "tenant.create")
+	defer span.End()
+
+	if !tenancy.IsOperator(ctx) {
+		err := apierrors.New(v1.ErrorCode_ERROR_CODE_PERMISSION_DENIED).WithMetadata("permission", middleware.CrossTenantPermission)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	tenant, err := t.store.Create(ctx, request.GetName())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	return &v1.TenantCreateResponse{
+		Tenant: tenant,
+	}, nil
+}
+
+// List - List Tenants, callers bound to a tenant can not see the others
+func (t *TenantServer) List(ctx context.Context, request *v1.TenantListRequest) (*v1.TenantListResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "tenant.list")
+	defer span.End()
+
+	if !tenancy.IsOperator(ctx) {
+		err := apierrors.New(v1.ErrorCode_ERROR_CODE_PERMISSION_DENIED).WithMetadata("permission", middleware.CrossTenantPermission)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	opts := []database.PaginationOption{
+		database.Size(request.GetSize()),
+		database.OrderBy(storage.TenantOrder),
+	}
+
+	if request.GetPageToken() != "" {
+		value, err := t.signer.Verify(request.GetPageToken())
+		if err != nil {
+			err = apierrors.New(v1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			slog.ErrorContext(ctx, err.Error())
+			return nil, apierrors.FromError(err)
+		}
+		opts = append(opts, database.Token(value))
+	}
+
+	tenants, ct, err := t.store.List(ctx, database.NewPagination(opts...))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	return &v1.TenantListResponse{
+		Tenants:       tenants,
+		NextPageToken: t.signer.Sign(ct.String()),
+	}, nil
+}
+
+// Delete - Delete Tenant along with its users, reserved to callers who may act in any tenant
+func (t *TenantServer) Delete(ctx context.Context, request *v1.TenantDeleteRequest) (*v1.MessageResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "tenant.delete")
+	defer span.End()
+
+	if !tenancy.IsOperator(ctx) {
+		err := apierrors.New(v1.ErrorCode_ERROR_CODE_PERMISSION_DENIED).WithMetadata("permission", middleware.CrossTenantPermission)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	err := t.store.Delete(ctx, request.GetId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	return &v1.MessageResponse{
+		Message: "success",
+	}, nil
+}
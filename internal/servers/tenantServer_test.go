@@ -0,0 +1,50 @@
+package servers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	memoryStorage "github.com/tolgaOzen/go-skeleton/internal/storage/memory"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	v1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+	"github.com/tolgaOzen/go-skeleton/pkg/token"
+)
+
+func TestTenantServerOperatorsOnly(t *testing.T) {
+	db, err := memory.New(memoryStorage.Schema)
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.NoError(t, memoryStorage.Seed(db))
+
+	s := NewTenantServer(memoryStorage.NewTenantStore(db), token.NewSigner([]byte("secret")))
+	operator := tenancy.Operator(context.Background())
+
+	created, err := s.Create(operator, &v1.TenantCreateRequest{Name: "acme"})
+	assert.NoError(t, err)
+	acme := created.GetTenant().GetId()
+
+	denied := func(err error) {
+		t.Helper()
+		assert.Equal(t, v1.ErrorCode_ERROR_CODE_PERMISSION_DENIED, apierrors.FromError(err).Code)
+	}
+
+	// callers bound to a tenant can not create tenants
+	bound := tenancy.NewContext(context.Background(), acme)
+	_, err = s.Create(bound, &v1.TenantCreateRequest{Name: "globex"})
+	denied(err)
+
+	// nor delete their own tenant
+	_, err = s.Delete(bound, &v1.TenantDeleteRequest{Id: acme})
+	denied(err)
+
+	// callers without a tenant are made in the default one, which they can not delete either
+	_, err = s.Delete(context.Background(), &v1.TenantDeleteRequest{Id: tenancy.DefaultTenant})
+	denied(err)
+
+	_, err = s.Delete(operator, &v1.TenantDeleteRequest{Id: acme})
+	assert.NoError(t, err)
+}
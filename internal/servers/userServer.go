@@ -2,65 +2,465 @@ package servers
 
 import (
 	"context"
+	"errors"
+	"io"
 	"log/slog"
+	"strconv"
 
 	"go.opentelemetry.io/otel/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/tolgaOzen/go-skeleton/internal"
 	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
 	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	"github.com/tolgaOzen/go-skeleton/pkg/etag"
 	v1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+	"github.com/tolgaOzen/go-skeleton/pkg/token"
+)
+
+const (
+	// importBatchSize - Number of imported users written per transaction
+	importBatchSize = 500
+	// maxImportFailures - Number of failing users described in the import summary
+	maxImportFailures = 100
+)
+
+const (
+	// IfMatchHeader - Metadata key of the If-Match header, an alternative to the revision field of writes
+	IfMatchHeader = "if-match"
+	// ETagHeader - Metadata key of the entity tag of the returned user
+	ETagHeader = "etag"
 )
 
 // UserServer - Structure for User Server
 type UserServer struct {
 	v1.UnimplementedUserServiceServer
 
-	dr storage.DataReader
-	dw storage.DataWriter
+	dr      storage.DataReader
+	dw      storage.DataWriter
+	watcher storage.Watcher
+	signer  *token.Signer
 }
 
 // NewUserServer - Creates new User Server
-func NewUserServer(dr storage.DataReader, dw storage.DataWriter) *UserServer {
+func NewUserServer(dr storage.DataReader, dw storage.DataWriter, watcher storage.Watcher, signer *token.Signer) *UserServer {
 	return &UserServer{
-		dr: dr,
-		dw: dw,
+		dr:      dr,
+		dw:      dw,
+		watcher: watcher,
+		signer:  signer,
 	}
 }
 
-// Create - Create new Tenant
-func (t *UserServer) Create(ctx context.Context, request *v1.UserCreateRequest) (*v1.MessageResponse, error) {
+// Create - Create new User
+func (t *UserServer) Create(ctx context.Context, request *v1.UserCreateRequest) (*v1.UserCreateResponse, error) {
 	ctx, span := internal.Tracer.Start(ctx, "user.create")
 	defer span.End()
 
-	err := t.dw.Write(ctx, request.GetName())
+	user, err := t.dw.Write(ctx, request.GetId(), request.GetName())
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		slog.ErrorContext(ctx, err.Error())
-		return nil, status.Error(GetStatus(err), err.Error())
+		return nil, apierrors.FromError(err)
 	}
 
-	return &v1.MessageResponse{
-		Message: "success",
+	setETag(ctx, user)
+
+	return &v1.UserCreateResponse{
+		User: user,
 	}, nil
 }
 
+// BatchCreate - Create Users
+func (t *UserServer) BatchCreate(ctx context.Context, request *v1.UserBatchCreateRequest) (*v1.UserBatchCreateResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "user.batch-create")
+	defer span.End()
+
+	users := make([]storage.User, 0, len(request.GetUsers()))
+	for _, u := range request.GetUsers() {
+		users = append(users, storage.User{ExternalID: u.GetId(), Name: u.GetName()})
+	}
+
+	results, err := t.dw.WriteBatch(ctx, users, request.GetAllowPartial())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	response := &v1.UserBatchCreateResponse{
+		Results: make([]*v1.UserResult, 0, len(results)),
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			response.Results = append(response.Results, &v1.UserResult{Error: apierrors.FromError(result.Err).Response()})
+			continue
+		}
+		response.Results = append(response.Results, &v1.UserResult{User: result.User})
+	}
+
+	return response, nil
+}
+
 // List - List Users
 func (t *UserServer) List(ctx context.Context, request *v1.UserListRequest) (*v1.UserListResponse, error) {
 	ctx, span := internal.Tracer.Start(ctx, "user.list")
 	defer span.End()
 
-	users, err := t.dr.ReadUsers(ctx, database.NewPagination(database.Size(request.GetSize()), database.Page(request.GetPage())))
+	order := storage.DefaultUserOrder
+	if request.GetOrderBy() != "" {
+		var err error
+		order, err = database.ParseOrder(request.GetOrderBy(), storage.UserOrderFields...)
+		if err != nil {
+			err = apierrors.Newf(v1.ErrorCode_ERROR_CODE_VALIDATION, "%s", err)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			slog.ErrorContext(ctx, err.Error())
+			return nil, apierrors.FromError(err)
+		}
+	}
+
+	opts := []database.PaginationOption{
+		database.Size(request.GetSize()),
+		database.Page(request.GetPage()), //nolint:staticcheck // offset pagination is kept as a fallback for clients without page tokens
+		database.OrderBy(order),
+	}
+
+	filter := storage.UserFilter{
+		NamePrefix:   request.GetNamePrefix(),
+		NameContains: request.GetNameContains(),
+		ShowDeleted:  request.GetShowDeleted(),
+	}
+	if request.GetCreatedAfter() != nil {
+		filter.CreatedAfter = request.GetCreatedAfter().AsTime()
+	}
+	if request.GetCreatedBefore() != nil {
+		filter.CreatedBefore = request.GetCreatedBefore().AsTime()
+	}
+
+	if request.GetPageToken() != "" {
+		value, err := t.signer.Verify(request.GetPageToken())
+		if err != nil {
+			err = apierrors.New(v1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			slog.ErrorContext(ctx, err.Error())
+			return nil, apierrors.FromError(err)
+		}
+		opts = append(opts, database.Token(value))
+	}
+
+	users, ct, err := t.dr.ReadUsers(ctx, filter, database.NewPagination(opts...))
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		slog.ErrorContext(ctx, err.Error())
-		return nil, status.Error(GetStatus(err), err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	var total int64
+	if !request.GetSkipTotalSize() {
+		total, err = t.dr.CountUsers(ctx, filter)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			slog.ErrorContext(ctx, err.Error())
+			return nil, apierrors.FromError(err)
+		}
 	}
 
 	return &v1.UserListResponse{
-		Users: users,
+		Users:         users,
+		NextPageToken: t.signer.Sign(ct.String()),
+		TotalSize:     total,
 	}, nil
 }
+
+// Get - Get User
+func (t *UserServer) Get(ctx context.Context, request *v1.UserGetRequest) (*v1.UserGetResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "user.get")
+	defer span.End()
+
+	user, err := t.dr.ReadUser(ctx, request.GetId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	setETag(ctx, user)
+
+	return &v1.UserGetResponse{
+		User: user,
+	}, nil
+}
+
+// BatchGet - Get Users
+func (t *UserServer) BatchGet(ctx context.Context, request *v1.UserBatchGetRequest) (*v1.UserBatchGetResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "user.batch-get")
+	defer span.End()
+
+	users, err := t.dr.ReadUsersByIDs(ctx, request.GetIds())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	found := make(map[uint64]*v1.User, len(users))
+	for _, user := range users {
+		found[user.GetId()] = user
+	}
+
+	response := &v1.UserBatchGetResponse{
+		Results: make([]*v1.UserResult, 0, len(request.GetIds())),
+	}
+	for _, id := range request.GetIds() {
+		user, ok := found[id]
+		if !ok {
+			notFound := apierrors.New(v1.ErrorCode_ERROR_CODE_NOT_FOUND).WithMetadata("id", strconv.FormatUint(id, 10))
+			response.Results = append(response.Results, &v1.UserResult{Error: notFound.Response()})
+			continue
+		}
+		response.Results = append(response.Results, &v1.UserResult{User: user})
+	}
+
+	return response, nil
+}
+
+// Export - Export Users
+func (t *UserServer) Export(request *v1.UserExportRequest, stream v1.UserService_ExportServer) error {
+	ctx, span := internal.Tracer.Start(stream.Context(), "user.export")
+	defer span.End()
+
+	err := t.dr.ExportUsers(ctx, storage.UserFilter{ShowDeleted: request.GetShowDeleted()}, func(user *v1.User) error {
+		return stream.Send(&v1.UserExportResponse{User: user})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return apierrors.FromError(err)
+	}
+
+	return nil
+}
+
+// Watch - Watch Users, the changes are streamed in transaction order until the client goes away. Without a resume
+// token the stream starts with the changes made after it was opened.
+func (t *UserServer) Watch(request *v1.UserWatchRequest, stream v1.UserService_WatchServer) error {
+	ctx, span := internal.Tracer.Start(stream.Context(), "user.watch")
+	defer span.End()
+
+	var after uint64
+	var err error
+	if request.GetResumeToken() != "" {
+		after, err = t.resumeAfter(request.GetResumeToken())
+	} else {
+		after, err = t.watcher.Head(ctx)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return apierrors.FromError(err)
+	}
+
+	err = t.watcher.Watch(ctx, after, func(event storage.UserEvent) error {
+		e := event.ToProto()
+		e.ResumeToken = t.signer.Sign(storage.EventToken(event.ID).String())
+		return stream.Send(&v1.UserWatchResponse{Event: e})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return apierrors.FromError(err)
+	}
+
+	return nil
+}
+
+// resumeAfter - Id of the event a signed resume token was issued for
+func (t *UserServer) resumeAfter(resumeToken string) (uint64, error) {
+	value, err := t.signer.Verify(resumeToken)
+	if err != nil {
+		return 0, apierrors.New(v1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+	}
+	id, err := storage.ParseEventToken(value)
+	if err != nil {
+		return 0, apierrors.New(v1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+	}
+	return id, nil
+}
+
+// Import - Import Users, the users are written in batches and failing users are skipped. When the stream
+// breaks off the batches written so far are kept.
+func (t *UserServer) Import(stream v1.UserService_ImportServer) error {
+	ctx, span := internal.Tracer.Start(stream.Context(), "user.import")
+	defer span.End()
+
+	response := &v1.UserImportResponse{}
+	batch := make([]storage.User, 0, importBatchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		results, err := t.dw.WriteBatch(ctx, batch, true)
+		if err != nil {
+			return err
+		}
+		offset := response.GetReceived() - uint64(len(batch))
+		for i, result := range results {
+			if result.Err == nil {
+				response.Created++
+				continue
+			}
+			response.Failed++
+			if len(response.GetFailures()) < maxImportFailures {
+				response.Failures = append(response.Failures, &v1.UserImportFailure{
+					Index: offset + uint64(i),
+					Error: apierrors.FromError(result.Err).Response(),
+				})
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	fail := func(err error) error {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return apierrors.FromError(err)
+	}
+
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fail(err)
+		}
+
+		batch = append(batch, storage.User{ExternalID: request.GetId(), Name: request.GetName()})
+		response.Received++
+		if len(batch) == importBatchSize {
+			if err = flush(); err != nil {
+				return fail(err)
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return fail(err)
+	}
+
+	return stream.SendAndClose(response)
+}
+
+// Update - Update User
+func (t *UserServer) Update(ctx context.Context, request *v1.UserUpdateRequest) (*v1.UserUpdateResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "user.update")
+	defer span.End()
+
+	revision, err := expectedRevision(ctx, request.GetRevision())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	user, err := t.dw.Update(ctx, request.GetId(), request.GetName(), revision)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	setETag(ctx, user)
+
+	return &v1.UserUpdateResponse{
+		User: user,
+	}, nil
+}
+
+// Delete - Delete User
+func (t *UserServer) Delete(ctx context.Context, request *v1.UserDeleteRequest) (*v1.MessageResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "user.delete")
+	defer span.End()
+
+	revision, err := expectedRevision(ctx, request.GetRevision())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	err = t.dw.Delete(ctx, request.GetId(), revision)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	return &v1.MessageResponse{
+		Message: "success",
+	}, nil
+}
+
+// Undelete - Undelete User
+func (t *UserServer) Undelete(ctx context.Context, request *v1.UserUndeleteRequest) (*v1.UserUndeleteResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "user.undelete")
+	defer span.End()
+
+	user, err := t.dw.Undelete(ctx, request.GetId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, apierrors.FromError(err)
+	}
+
+	setETag(ctx, user)
+
+	return &v1.UserUndeleteResponse{
+		User: user,
+	}, nil
+}
+
+// expectedRevision - Returns the revision a write is conditional on, taken from the request field or else the
+// If-Match header. 0 makes the write unconditional.
+func expectedRevision(ctx context.Context, revision uint64) (uint64, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(IfMatchHeader)
+	if len(values) == 0 {
+		return revision, nil
+	}
+
+	matched, err := etag.Parse(values[0])
+	if err != nil {
+		return 0, apierrors.Newf(v1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, "invalid If-Match header %q", values[0])
+	}
+	if revision != 0 && matched != 0 && revision != matched {
+		return 0, apierrors.Newf(v1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, "revision %d does not match If-Match header %q", revision, values[0])
+	}
+	return max(revision, matched), nil
+}
+
+// setETag - Sends the entity tag of the user as a response header, surfaced as ETag by the gateway
+func setETag(ctx context.Context, user *v1.User) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(ETagHeader, user.GetEtag()))
+}
@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgraph-io/ristretto/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+)
+
+// Cache - Bounded in-process cache shared by the caching data reader and data writer.
+//
+// Every key is prefixed with a generation that writes increment, so a write invalidates
+// everything cached before it at once, including reads that were in flight during the
+// write. Only writes going through this process are seen, other instances serve stale
+// entries until their TTL expires.
+type Cache struct {
+	store      *ristretto.Cache[string, interface{}]
+	ttl        time.Duration
+	generation atomic.Uint64
+
+	hits   metric.Int64Counter
+	misses metric.Int64Counter
+}
+
+// New - Creates a new cache from the configuration
+func New(cfg config.Cache) (*Cache, error) {
+	store, err := ristretto.NewCache(&ristretto.Config[string, interface{}]{
+		NumCounters: cfg.NumCounters,
+		MaxCost:     cfg.MaxCost,
+		BufferItems: 64,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hits, err := internal.Meter.Int64Counter("cache_hits", metric.WithDescription("Number of storage reads served from the cache"))
+	if err != nil {
+		return nil, err
+	}
+
+	misses, err := internal.Meter.Int64Counter("cache_misses", metric.WithDescription("Number of storage reads that missed the cache"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache{
+		store:  store,
+		ttl:    cfg.TTL,
+		hits:   hits,
+		misses: misses,
+	}, nil
+}
+
+// key - Builds the key of an entry of the context's tenant in the current generation, tenants never share entries
+func (c *Cache) key(ctx context.Context, parts ...interface{}) string {
+	return fmt.Sprintf("%d:%d:%v", c.generation.Load(), tenancy.ID(ctx), parts)
+}
+
+// get - Returns the cached value and records the hit or miss
+func (c *Cache) get(ctx context.Context, method, key string) (interface{}, bool) {
+	value, ok := c.store.Get(key)
+	attrs := metric.WithAttributes(attribute.String("method", method))
+	if ok {
+		c.hits.Add(ctx, 1, attrs)
+	} else {
+		c.misses.Add(ctx, 1, attrs)
+	}
+	return value, ok
+}
+
+// set - Caches the value with its cost in bytes
+func (c *Cache) set(key string, value interface{}, cost int64) {
+	c.store.SetWithTTL(key, value, cost, c.ttl)
+}
+
+// invalidate - Moves to a new generation, entries of the previous ones are never read again and age out
+func (c *Cache) invalidate() {
+	c.generation.Add(1)
+}
+
+// Wait - Blocks until buffered sets are applied
+func (c *Cache) Wait() {
+	c.store.Wait()
+}
+
+// Close - Stops the cache
+func (c *Cache) Close() {
+	c.store.Close()
+}
@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/storage/memory"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	MMDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+func TestCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cache-suite")
+}
+
+// countingReader counts the reads reaching the datastore
+type countingReader struct {
+	storage.DataReader
+	users int
+	user  int
+}
+
+func (r *countingReader) ReadUsers(ctx context.Context, filter storage.UserFilter, pagination database.Pagination) ([]*base.User, database.EncodedContinuousToken, error) {
+	r.users++
+	return r.DataReader.ReadUsers(ctx, filter, pagination)
+}
+
+func (r *countingReader) ReadUser(ctx context.Context, id uint64) (*base.User, error) {
+	r.user++
+	return r.DataReader.ReadUser(ctx, id)
+}
+
+var _ = Describe("Cache", func() {
+	var (
+		ctx     context.Context
+		db      *MMDatabase.Memory
+		c       *Cache
+		counter *countingReader
+		reader  *DataReader
+		writer  *DataWriter
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+
+		db, err = MMDatabase.New(memory.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(memory.Seed(db)).Should(Succeed())
+
+		c, err = New(config.Cache{NumCounters: 1000, MaxCost: 1 << 20, TTL: time.Minute})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		counter = &countingReader{DataReader: memory.NewDataReader(db)}
+		reader = NewDataReader(counter, c)
+		writer = NewDataWriter(memory.NewDataWriter(db), c)
+
+		_, err = writer.Write(ctx, "ext-user-1", "user-1")
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		c.Close()
+		Expect(db.Close()).Should(Succeed())
+	})
+
+	It("should serve repeated lists from the cache", func() {
+		pagination := database.NewPagination(database.Size(10))
+
+		users, _, err := reader.ReadUsers(ctx, storage.UserFilter{}, pagination)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(users).Should(HaveLen(1))
+		c.Wait()
+
+		users, _, err = reader.ReadUsers(ctx, storage.UserFilter{}, pagination)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(users).Should(HaveLen(1))
+		Expect(counter.users).Should(Equal(1))
+	})
+
+	It("should invalidate lists on writes", func() {
+		pagination := database.NewPagination(database.Size(10))
+
+		_, _, err := reader.ReadUsers(ctx, storage.UserFilter{}, pagination)
+		Expect(err).ShouldNot(HaveOccurred())
+		c.Wait()
+
+		_, err = writer.Write(ctx, "ext-user-2", "user-2")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		users, _, err := reader.ReadUsers(ctx, storage.UserFilter{}, pagination)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(users).Should(HaveLen(2))
+		Expect(counter.users).Should(Equal(2))
+	})
+
+	It("should invalidate users on updates", func() {
+		users, _, err := reader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(10)))
+		Expect(err).ShouldNot(HaveOccurred())
+		id := users[0].GetId()
+
+		_, err = reader.ReadUser(ctx, id)
+		Expect(err).ShouldNot(HaveOccurred())
+		c.Wait()
+
+		_, err = reader.ReadUser(ctx, id)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(counter.user).Should(Equal(1))
+
+		_, err = writer.Update(ctx, id, "renamed", 0)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		user, err := reader.ReadUser(ctx, id)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(user.GetName()).Should(Equal("renamed"))
+		Expect(counter.user).Should(Equal(2))
+	})
+
+	It("should not cache errors", func() {
+		_, err := reader.ReadUser(ctx, 999)
+		Expect(err).Should(HaveOccurred())
+		_, err = reader.ReadUser(ctx, 999)
+		Expect(err).Should(HaveOccurred())
+		Expect(counter.user).Should(Equal(2))
+	})
+})
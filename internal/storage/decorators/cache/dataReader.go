@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// DataReader - Add read-through cache behaviour to data reader
+type DataReader struct {
+	delegate storage.DataReader
+	cache    *Cache
+}
+
+// NewDataReader - Add read-through cache behaviour to new data reader
+func NewDataReader(delegate storage.DataReader, cache *Cache) *DataReader {
+	return &DataReader{delegate: delegate, cache: cache}
+}
+
+// page is a cached result of ReadUsers
+type page struct {
+	users []*base.User
+	ct    database.EncodedContinuousToken
+}
+
+// ReadUsers - Read users from the cache, falling back to the delegate
+func (r *DataReader) ReadUsers(ctx context.Context, filter storage.UserFilter, pagination database.Pagination) ([]*base.User, database.EncodedContinuousToken, error) {
+	key := r.cache.key(ctx, "users", filterKey(filter), pagination.PageSize(), pagination.Page(), pagination.Token(), pagination.Order().String())
+	if value, ok := r.cache.get(ctx, "read_users", key); ok {
+		p := value.(page)
+		return p.users, p.ct, nil
+	}
+
+	users, ct, err := r.delegate.ReadUsers(ctx, filter, pagination)
+	if err != nil {
+		return nil, ct, err
+	}
+
+	cost := int64(len(ct.String()))
+	for _, u := range users {
+		cost += int64(proto.Size(u))
+	}
+	r.cache.set(key, page{users: users, ct: ct}, max(cost, 1))
+
+	return users, ct, nil
+}
+
+// CountUsers - Count users from the cache, falling back to the delegate
+func (r *DataReader) CountUsers(ctx context.Context, filter storage.UserFilter) (int64, error) {
+	key := r.cache.key(ctx, "count_users", filterKey(filter))
+	if value, ok := r.cache.get(ctx, "count_users", key); ok {
+		return value.(int64), nil
+	}
+
+	count, err := r.delegate.CountUsers(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	r.cache.set(key, count, 1)
+
+	return count, nil
+}
+
+// ReadUser - Read user from the cache, falling back to the delegate
+func (r *DataReader) ReadUser(ctx context.Context, id uint64) (*base.User, error) {
+	key := r.cache.key(ctx, "user", id)
+	if value, ok := r.cache.get(ctx, "read_user", key); ok {
+		return value.(*base.User), nil
+	}
+
+	user, err := r.delegate.ReadUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.set(key, user, int64(max(proto.Size(user), 1)))
+
+	return user, nil
+}
+
+// filterKey - Unambiguous cache key part of the filter, search text is quoted so it can not run into the other parts
+func filterKey(filter storage.UserFilter) string {
+	return fmt.Sprintf("%q %q %d %d %t", filter.NamePrefix, filter.NameContains, filter.CreatedAfter.UnixNano(), filter.CreatedBefore.UnixNano(), filter.ShowDeleted)
+}
+
+// ReadUsersByIDs - Read users by ids from the cache, only the ids that are not cached are read from the delegate
+func (r *DataReader) ReadUsersByIDs(ctx context.Context, ids []uint64) ([]*base.User, error) {
+	users := make([]*base.User, 0, len(ids))
+	var missing []uint64
+	for _, id := range ids {
+		if value, ok := r.cache.get(ctx, "read_users_by_ids", r.cache.key(ctx, "user", id)); ok {
+			users = append(users, value.(*base.User))
+			continue
+		}
+		missing = append(missing, id)
+	}
+	if len(missing) == 0 {
+		return users, nil
+	}
+
+	fetched, err := r.delegate.ReadUsersByIDs(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range fetched {
+		r.cache.set(r.cache.key(ctx, "user", user.GetId()), user, int64(max(proto.Size(user), 1)))
+	}
+
+	return append(users, fetched...), nil
+}
+
+// ExportUsers - Export users from the delegate, exports read every user once so caching them would only
+// evict the entries of regular reads
+func (r *DataReader) ExportUsers(ctx context.Context, filter storage.UserFilter, fn func(user *base.User) error) error {
+	return r.delegate.ExportUsers(ctx, filter, fn)
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// DataWriter - Add cache invalidation behaviour to data writer
+type DataWriter struct {
+	delegate storage.DataWriter
+	cache    *Cache
+}
+
+// NewDataWriter - Add cache invalidation behaviour to new data writer
+func NewDataWriter(delegate storage.DataWriter, cache *Cache) *DataWriter {
+	return &DataWriter{delegate: delegate, cache: cache}
+}
+
+// Write - Write user and invalidate the cache
+func (w *DataWriter) Write(ctx context.Context, externalID, name string) (*base.User, error) {
+	defer w.cache.invalidate()
+	return w.delegate.Write(ctx, externalID, name)
+}
+
+// WriteBatch - Write users and invalidate the cache
+func (w *DataWriter) WriteBatch(ctx context.Context, users []storage.User, partial bool) ([]storage.UserResult, error) {
+	defer w.cache.invalidate()
+	return w.delegate.WriteBatch(ctx, users, partial)
+}
+
+// Update - Update user and invalidate the cache
+func (w *DataWriter) Update(ctx context.Context, id uint64, name string, revision uint64) (*base.User, error) {
+	defer w.cache.invalidate()
+	return w.delegate.Update(ctx, id, name, revision)
+}
+
+// Delete - Delete user and invalidate the cache
+func (w *DataWriter) Delete(ctx context.Context, id uint64, revision uint64) error {
+	defer w.cache.invalidate()
+	return w.delegate.Delete(ctx, id, revision)
+}
+
+// Undelete - Undelete user and invalidate the cache
+func (w *DataWriter) Undelete(ctx context.Context, id uint64) (*base.User, error) {
+	defer w.cache.invalidate()
+	return w.delegate.Undelete(ctx, id)
+}
+
+// Purge - Purge deleted users and invalidate the cache
+func (w *DataWriter) Purge(ctx context.Context, before time.Time) (int64, error) {
+	defer w.cache.invalidate()
+	return w.delegate.Purge(ctx, before)
+}
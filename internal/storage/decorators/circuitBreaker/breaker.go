@@ -0,0 +1,68 @@
+package circuitBreaker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/sony/gobreaker"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// NewCircuitBreaker - Creates a circuit breaker from the configuration. State changes are logged and
+// reported by the circuit_breaker_state gauge (0 closed, 1 half-open, 2 open).
+func NewCircuitBreaker(name string, cfg config.Breaker) (*gobreaker.CircuitBreaker, error) {
+	st := gobreaker.Settings{
+		Name:        name,
+		MaxRequests: cfg.MaxRequests,
+		Interval:    cfg.Interval,
+		Timeout:     cfg.Timeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
+			return counts.Requests >= cfg.MinRequests && failureRatio >= cfg.FailureRatio
+		},
+		// Only internal failures count, client errors such as missing rows or
+		// conflicts are normal outcomes and must not trip the breaker.
+		IsSuccessful: func(err error) bool {
+			return err == nil || apierrors.GRPCCode(apierrors.FromError(err).Code) != codes.Internal
+		},
+		OnStateChange: func(name string, from, to gobreaker.State) {
+			slog.Warn("circuit breaker state changed", slog.String("name", name), slog.String("from", from.String()), slog.String("to", to.String()))
+		},
+	}
+
+	cb := gobreaker.NewCircuitBreaker(st)
+
+	gauge, err := internal.Meter.Int64ObservableGauge(
+		"circuit_breaker_state",
+		metric.WithDescription("State of the circuit breaker: 0 closed, 1 half-open, 2 open"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := metric.WithAttributes(attribute.String("name", name))
+	if _, err = internal.Meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(gauge, int64(cb.State()), attrs)
+		return nil
+	}, gauge); err != nil {
+		return nil, err
+	}
+
+	return cb, nil
+}
+
+// translate - Converts the errors of an open breaker to ERROR_CODE_CIRCUIT_BREAKER so clients get UNAVAILABLE
+func translate(err error) error {
+	if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+		return apierrors.Wrap(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER, err).WithMessage("datastore is unavailable, try again later")
+	}
+	return err
+}
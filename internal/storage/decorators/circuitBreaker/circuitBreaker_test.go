@@ -0,0 +1,78 @@
+package circuitBreaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "circuit-breaker-suite")
+}
+
+// failingWriter fails every call with the configured error
+type failingWriter struct {
+	storage.NoopDataWriter
+	err error
+}
+
+func (w *failingWriter) Write(_ context.Context, _, _ string) (*base.User, error) {
+	return nil, w.err
+}
+
+var _ = Describe("DataWriter", func() {
+	var (
+		ctx context.Context
+		cb  *gobreaker.CircuitBreaker
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		cb, err = NewCircuitBreaker("test", config.Breaker{
+			MinRequests:  2,
+			FailureRatio: 0.5,
+			Timeout:      time.Minute,
+			MaxRequests:  1,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should open on internal failures and return unavailable", func() {
+		writer := NewDataWriter(&failingWriter{err: errors.New("connection refused")}, cb)
+
+		_, err := writer.Write(ctx, "tolga", "tolga")
+		Expect(err).Should(HaveOccurred())
+		_, err = writer.Write(ctx, "tolga", "tolga")
+		Expect(err).Should(HaveOccurred())
+		Expect(cb.State()).Should(Equal(gobreaker.StateOpen))
+
+		_, err = writer.Write(ctx, "tolga", "tolga")
+		Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String()))
+		Expect(status.Code(err)).Should(Equal(codes.Unavailable))
+	})
+
+	It("should not open on client errors", func() {
+		writer := NewDataWriter(&failingWriter{err: apierrors.New(base.ErrorCode_ERROR_CODE_NOT_FOUND)}, cb)
+
+		for i := 0; i < 5; i++ {
+			_, err := writer.Write(ctx, "tolga", "tolga")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_NOT_FOUND.String()))
+		}
+		Expect(cb.State()).Should(Equal(gobreaker.StateClosed))
+	})
+})
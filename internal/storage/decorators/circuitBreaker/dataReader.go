@@ -22,12 +22,71 @@ func NewDataReader(delegate storage.DataReader, cb *gobreaker.CircuitBreaker) *D
 }
 
 // ReadUsers - Read users with circuit breaker
-func (r *DataReader) ReadUsers(ctx context.Context, pagination database.Pagination) ([]*base.User, error) {
+func (r *DataReader) ReadUsers(ctx context.Context, filter storage.UserFilter, pagination database.Pagination) ([]*base.User, database.EncodedContinuousToken, error) {
+	type resp struct {
+		Users []*base.User
+		Ct    database.EncodedContinuousToken
+	}
+
 	response, err := r.cb.Execute(func() (interface{}, error) {
-		return r.delegate.ReadUsers(ctx, pagination)
+		users, ct, err := r.delegate.ReadUsers(ctx, filter, pagination)
+		return resp{Users: users, Ct: ct}, err
 	})
 	if err != nil {
-		return nil, err
+		return nil, database.NewEncodedContinuousToken(""), translate(err)
+	}
+	return response.(resp).Users, response.(resp).Ct, nil
+}
+
+// CountUsers - Count users with circuit breaker
+func (r *DataReader) CountUsers(ctx context.Context, filter storage.UserFilter) (int64, error) {
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		return r.delegate.CountUsers(ctx, filter)
+	})
+	if err != nil {
+		return 0, translate(err)
+	}
+	return response.(int64), nil
+}
+
+// ReadUser - Read user with circuit breaker
+func (r *DataReader) ReadUser(ctx context.Context, id uint64) (*base.User, error) {
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		return r.delegate.ReadUser(ctx, id)
+	})
+	if err != nil {
+		return nil, translate(err)
+	}
+	return response.(*base.User), nil
+}
+
+// ReadUsersByIDs - Read users by ids with circuit breaker
+func (r *DataReader) ReadUsersByIDs(ctx context.Context, ids []uint64) ([]*base.User, error) {
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		return r.delegate.ReadUsersByIDs(ctx, ids)
+	})
+	if err != nil {
+		return nil, translate(err)
 	}
 	return response.([]*base.User), nil
 }
+
+// ExportUsers - Export users with circuit breaker, errors of fn mean the receiving side went away and do not
+// count as failures of the datastore
+func (r *DataReader) ExportUsers(ctx context.Context, filter storage.UserFilter, fn func(user *base.User) error) error {
+	var fnErr error
+	_, err := r.cb.Execute(func() (interface{}, error) {
+		err := r.delegate.ExportUsers(ctx, filter, func(user *base.User) error {
+			fnErr = fn(user)
+			return fnErr
+		})
+		if fnErr != nil {
+			return nil, nil
+		}
+		return nil, err
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	return translate(err)
+}
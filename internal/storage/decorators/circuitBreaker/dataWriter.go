@@ -0,0 +1,85 @@
+package circuitBreaker
+
+import (
+	"context"
+	"time"
+
+	"github.com/sony/gobreaker"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// DataWriter - Add circuit breaker behaviour to data writer
+type DataWriter struct {
+	delegate storage.DataWriter
+	cb       *gobreaker.CircuitBreaker
+}
+
+// NewDataWriter - Add circuit breaker behaviour to new data writer
+func NewDataWriter(delegate storage.DataWriter, cb *gobreaker.CircuitBreaker) *DataWriter {
+	return &DataWriter{delegate: delegate, cb: cb}
+}
+
+// Write - Write user with circuit breaker
+func (w *DataWriter) Write(ctx context.Context, externalID, name string) (*base.User, error) {
+	response, err := w.cb.Execute(func() (interface{}, error) {
+		return w.delegate.Write(ctx, externalID, name)
+	})
+	if err != nil {
+		return nil, translate(err)
+	}
+	return response.(*base.User), nil
+}
+
+// WriteBatch - Write users with circuit breaker
+func (w *DataWriter) WriteBatch(ctx context.Context, users []storage.User, partial bool) ([]storage.UserResult, error) {
+	response, err := w.cb.Execute(func() (interface{}, error) {
+		return w.delegate.WriteBatch(ctx, users, partial)
+	})
+	if err != nil {
+		return nil, translate(err)
+	}
+	return response.([]storage.UserResult), nil
+}
+
+// Update - Update user with circuit breaker
+func (w *DataWriter) Update(ctx context.Context, id uint64, name string, revision uint64) (*base.User, error) {
+	response, err := w.cb.Execute(func() (interface{}, error) {
+		return w.delegate.Update(ctx, id, name, revision)
+	})
+	if err != nil {
+		return nil, translate(err)
+	}
+	return response.(*base.User), nil
+}
+
+// Delete - Delete user with circuit breaker
+func (w *DataWriter) Delete(ctx context.Context, id uint64, revision uint64) error {
+	_, err := w.cb.Execute(func() (interface{}, error) {
+		return nil, w.delegate.Delete(ctx, id, revision)
+	})
+	return translate(err)
+}
+
+// Undelete - Undelete user with circuit breaker
+func (w *DataWriter) Undelete(ctx context.Context, id uint64) (*base.User, error) {
+	response, err := w.cb.Execute(func() (interface{}, error) {
+		return w.delegate.Undelete(ctx, id)
+	})
+	if err != nil {
+		return nil, translate(err)
+	}
+	return response.(*base.User), nil
+}
+
+// Purge - Purge deleted users with circuit breaker
+func (w *DataWriter) Purge(ctx context.Context, before time.Time) (int64, error) {
+	response, err := w.cb.Execute(func() (interface{}, error) {
+		return w.delegate.Purge(ctx, before)
+	})
+	if err != nil {
+		return 0, translate(err)
+	}
+	return response.(int64), nil
+}
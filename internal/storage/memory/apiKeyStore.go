@@ -0,0 +1,228 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// ApiKeyStore - Structure for in-memory Api Key Store
+type ApiKeyStore struct {
+	database *db.Memory
+}
+
+func NewApiKeyStore(database *db.Memory) *ApiKeyStore {
+	return &ApiKeyStore{
+		database: database,
+	}
+}
+
+// Create stores a key in the tenant, assigning it the next id from the database's api key counter.
+func (s *ApiKeyStore) Create(ctx context.Context, key storage.ApiKey) (created *basev1.ApiKey, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "api-key-store.create")
+	defer span.End()
+
+	slog.DebugContext(ctx, "create api key", slog.String("name", key.Name))
+
+	txn := s.database.DB.Txn(true)
+	defer txn.Abort()
+
+	stored, err := s.insert(txn, tenancy.ID(ctx), key)
+	if err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+	return stored.ToProto(), nil
+}
+
+// Get returns the key of the tenant with the id.
+func (s *ApiKeyStore) Get(ctx context.Context, id uint64) (key storage.ApiKey, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "api-key-store.get")
+	defer span.End()
+
+	txn := s.database.DB.Txn(false)
+	defer txn.Abort()
+
+	fnd, err := getApiKey(ctx, txn, id)
+	if err != nil {
+		return storage.ApiKey{}, err
+	}
+	return *fnd, nil
+}
+
+// List walks the keys of the tenant in id order, starting after the id of the token.
+func (s *ApiKeyStore) List(ctx context.Context, pagination database.Pagination) (keys []*basev1.ApiKey, ct database.EncodedContinuousToken, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "api-key-store.list")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying api keys")
+
+	txn := s.database.DB.Txn(false)
+	defer txn.Abort()
+
+	var after uint64
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = database.NewEncodedContinuousToken(pagination.Token()).Decode(storage.ApiKeyOrder)
+		if err != nil {
+			return nil, database.NewEncodedContinuousToken(""), apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+		}
+		after = t.ID
+	}
+
+	it, err := txn.LowerBound(ApiKeysTable, "id", after+1)
+	if err != nil {
+		return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("failed to query api keys: %w", err)
+	}
+
+	tenantID := tenancy.ID(ctx)
+	limit := int(pagination.Size())
+	var fetched []*storage.ApiKey
+	for obj := it.Next(); obj != nil && len(fetched) <= limit; obj = it.Next() {
+		if key := obj.(*storage.ApiKey); key.TenantID == tenantID {
+			fetched = append(fetched, key)
+		}
+	}
+
+	ct = database.NewEncodedContinuousToken("")
+	if len(fetched) > limit {
+		fetched = fetched[:limit]
+		if len(fetched) > 0 {
+			ct = database.NewContinuousToken(storage.ApiKeyOrder, "", fetched[len(fetched)-1].ID).Encode()
+		}
+	}
+
+	for _, key := range fetched {
+		keys = append(keys, key.ToProto())
+	}
+
+	return keys, ct, nil
+}
+
+// Revoke sets the revocation time of the key of the tenant unless it is already revoked.
+func (s *ApiKeyStore) Revoke(ctx context.Context, id uint64) (revoked *basev1.ApiKey, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "api-key-store.revoke")
+	defer span.End()
+
+	slog.DebugContext(ctx, "revoke api key", slog.Uint64("id", id))
+
+	txn := s.database.DB.Txn(true)
+	defer txn.Abort()
+
+	fnd, err := getApiKey(ctx, txn, id)
+	if err != nil {
+		return nil, err
+	}
+	if fnd.Revoked() {
+		return fnd.ToProto(), nil
+	}
+
+	// Stored objects are shared with readers, so the revoked key is inserted as a copy.
+	updated := *fnd
+	updated.RevokedAt = time.Now().UTC()
+	if err = txn.Insert(ApiKeysTable, &updated); err != nil {
+		return nil, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	txn.Commit()
+	return updated.ToProto(), nil
+}
+
+// Rotate revokes the key of the tenant and stores the replacement in the same transaction.
+func (s *ApiKeyStore) Rotate(ctx context.Context, id uint64, replacement storage.ApiKey) (created *basev1.ApiKey, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "api-key-store.rotate")
+	defer span.End()
+
+	slog.DebugContext(ctx, "rotate api key", slog.Uint64("id", id))
+
+	txn := s.database.DB.Txn(true)
+	defer txn.Abort()
+
+	fnd, err := getApiKey(ctx, txn, id)
+	if err != nil {
+		return nil, err
+	}
+	if fnd.Revoked() {
+		return nil, apierrors.Newf(basev1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, "api key %d is revoked", id)
+	}
+
+	revoked := *fnd
+	revoked.RevokedAt = time.Now().UTC()
+	if err = txn.Insert(ApiKeysTable, &revoked); err != nil {
+		return nil, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	replacement.Name = fnd.Name
+	replacement.Roles = fnd.Roles
+	if replacement.ExpiresAt.IsZero() {
+		replacement.ExpiresAt = fnd.ExpiresAt
+	}
+	stored, err := s.insert(txn, fnd.TenantID, replacement)
+	if err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+	return stored.ToProto(), nil
+}
+
+// Lookup finds the key of any tenant by the digest of its secret.
+func (s *ApiKeyStore) Lookup(ctx context.Context, hash string) (key storage.ApiKey, err error) {
+	// Start a new trace span and end it when the function exits.
+	_, span := internal.Tracer.Start(ctx, "api-key-store.lookup")
+	defer span.End()
+
+	txn := s.database.DB.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First(ApiKeysTable, "hash", hash)
+	if err != nil {
+		return storage.ApiKey{}, fmt.Errorf("failed to query api key: %w", err)
+	}
+	if raw == nil {
+		return storage.ApiKey{}, apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND)
+	}
+	return *raw.(*storage.ApiKey), nil
+}
+
+// insert stores the key in the tenant with the next id.
+func (s *ApiKeyStore) insert(txn *memdb.Txn, tenantID uint64, key storage.ApiKey) (*storage.ApiKey, error) {
+	key.ID = s.database.NextKID()
+	key.TenantID = tenantID
+	key.CreatedAt = time.Now().UTC()
+	key.RevokedAt = time.Time{}
+	if err := txn.Insert(ApiKeysTable, &key); err != nil {
+		return nil, fmt.Errorf("failed to insert api key: %w", err)
+	}
+	return &key, nil
+}
+
+// getApiKey returns the key with the id if it belongs to the tenant of the context.
+func getApiKey(ctx context.Context, txn *memdb.Txn, id uint64) (*storage.ApiKey, error) {
+	raw, err := txn.First(ApiKeysTable, "id", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query api key: %w", err)
+	}
+	if raw == nil || raw.(*storage.ApiKey).TenantID != tenancy.ID(ctx) {
+		return nil, apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND).WithMetadata("api_key_id", strconv.FormatUint(id, 10))
+	}
+	return raw.(*storage.ApiKey), nil
+}
@@ -0,0 +1,159 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	MMDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+var _ = Describe("ApiKeyStore", func() {
+	var db *MMDatabase.Memory
+	var store *ApiKeyStore
+	var tenants *TenantStore
+
+	BeforeEach(func() {
+		var err error
+		db, err = MMDatabase.New(Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(Seed(db)).Should(Succeed())
+
+		store = NewApiKeyStore(db)
+		tenants = NewTenantStore(db)
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	page := func(size uint32, token string) database.Pagination {
+		return database.NewPagination(database.Size(size), database.Token(token), database.OrderBy(storage.ApiKeyOrder))
+	}
+
+	key := func(name, hash string) storage.ApiKey {
+		return storage.ApiKey{Name: name, Prefix: "sk_" + name, Hash: hash, Roles: []string{"viewer"}}
+	}
+
+	Context("Create", func() {
+		It("creates keys in the tenant and finds them by hash", func() {
+			created, err := store.Create(context.Background(), key("ci", "hash-1"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(created.GetId()).ShouldNot(BeZero())
+			Expect(created.GetTenantId()).Should(Equal(tenancy.DefaultTenant))
+			Expect(created.GetRoles()).Should(Equal([]string{"viewer"}))
+			Expect(created.GetExpiresAt()).Should(BeNil())
+			Expect(created.GetRevokedAt()).Should(BeNil())
+
+			fnd, err := store.Lookup(context.Background(), "hash-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fnd.ID).Should(Equal(created.GetId()))
+
+			_, err = store.Lookup(context.Background(), "hash-2")
+			Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND))
+		})
+	})
+
+	Context("List", func() {
+		It("lists the keys of the tenant across pages", func() {
+			tenant, err := tenants.Create(context.Background(), "acme")
+			Expect(err).ShouldNot(HaveOccurred())
+			other := tenancy.NewContext(context.Background(), tenant.GetId())
+
+			for _, name := range []string{"a", "b", "c"} {
+				_, err = store.Create(context.Background(), key(name, "hash-"+name))
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			_, err = store.Create(other, key("d", "hash-d"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			keys, ct, err := store.List(context.Background(), page(2, ""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(keys).Should(HaveLen(2))
+			Expect(keys[0].GetName()).Should(Equal("a"))
+			Expect(ct.String()).ShouldNot(BeEmpty())
+
+			keys, ct, err = store.List(context.Background(), page(2, ct.String()))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(keys).Should(HaveLen(1))
+			Expect(keys[0].GetName()).Should(Equal("c"))
+			Expect(ct.String()).Should(BeEmpty())
+
+			keys, _, err = store.List(other, page(10, ""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(keys).Should(HaveLen(1))
+			Expect(keys[0].GetName()).Should(Equal("d"))
+		})
+	})
+
+	Context("Revoke", func() {
+		It("revokes a key once", func() {
+			created, err := store.Create(context.Background(), key("ci", "hash-1"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			revoked, err := store.Revoke(context.Background(), created.GetId())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(revoked.GetRevokedAt()).ShouldNot(BeNil())
+
+			again, err := store.Revoke(context.Background(), created.GetId())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again.GetRevokedAt().AsTime()).Should(Equal(revoked.GetRevokedAt().AsTime()))
+
+			fnd, err := store.Lookup(context.Background(), "hash-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fnd.Valid(time.Now())).Should(BeFalse())
+		})
+
+		It("does not revoke the keys of other tenants", func() {
+			created, err := store.Create(context.Background(), key("ci", "hash-1"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = store.Revoke(tenancy.NewContext(context.Background(), 2), created.GetId())
+			Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND))
+		})
+	})
+
+	Context("Rotate", func() {
+		It("revokes the key and stores its replacement", func() {
+			expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+			original := key("ci", "hash-1")
+			original.ExpiresAt = expiresAt
+			created, err := store.Create(context.Background(), original)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rotated, err := store.Rotate(context.Background(), created.GetId(), storage.ApiKey{Prefix: "sk_new", Hash: "hash-2"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rotated.GetId()).ShouldNot(Equal(created.GetId()))
+			Expect(rotated.GetName()).Should(Equal("ci"))
+			Expect(rotated.GetRoles()).Should(Equal([]string{"viewer"}))
+			Expect(rotated.GetExpiresAt().AsTime()).Should(Equal(expiresAt))
+
+			old, err := store.Get(context.Background(), created.GetId())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(old.Revoked()).Should(BeTrue())
+
+			_, err = store.Rotate(context.Background(), created.GetId(), storage.ApiKey{Prefix: "sk_new", Hash: "hash-3"})
+			Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT))
+		})
+	})
+
+	It("deletes the keys of a deleted tenant", func() {
+		tenant, err := tenants.Create(context.Background(), "acme")
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = store.Create(tenancy.NewContext(context.Background(), tenant.GetId()), key("ci", "hash-1"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(tenants.Delete(context.Background(), tenant.GetId())).Should(Succeed())
+
+		_, err = store.Lookup(context.Background(), "hash-1")
+		Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND))
+	})
+})
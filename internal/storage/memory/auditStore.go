@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// AuditStore - Structure for in-memory Audit Store
+type AuditStore struct {
+	database *db.Memory
+}
+
+func NewAuditStore(database *db.Memory) *AuditStore {
+	return &AuditStore{
+		database: database,
+	}
+}
+
+// Write appends an audit event to the tenant.
+func (s *AuditStore) Write(ctx context.Context, event storage.AuditEvent) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "audit-store.write")
+	defer span.End()
+
+	slog.DebugContext(ctx, "write audit event", slog.String("method", event.Method))
+
+	txn := s.database.DB.Txn(true)
+	defer txn.Abort()
+
+	event.ID = s.database.NextAID()
+	event.TenantID = tenancy.ID(ctx)
+	event.CreatedAt = time.Now().UTC()
+	if err = txn.Insert(AuditEventsTable, &event); err != nil {
+		return fmt.Errorf("failed to write audit event: %w", err)
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// List walks the audit events of the tenant from the latest one backwards, starting below the id of the token.
+func (s *AuditStore) List(ctx context.Context, filter storage.AuditFilter, pagination database.Pagination) (events []storage.AuditEvent, ct database.EncodedContinuousToken, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "audit-store.list")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying audit events")
+
+	txn := s.database.DB.Txn(false)
+	defer txn.Abort()
+
+	var it memdb.ResultIterator
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = database.NewEncodedContinuousToken(pagination.Token()).Decode(storage.AuditOrder)
+		if err != nil || t.ID == 0 {
+			return nil, database.NewEncodedContinuousToken(""), apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+		}
+		it, err = txn.ReverseLowerBound(AuditEventsTable, "id", t.ID-1)
+	} else {
+		it, err = txn.GetReverse(AuditEventsTable, "id")
+	}
+	if err != nil {
+		return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("failed to query audit events: %w", err)
+	}
+
+	tenantID := tenancy.ID(ctx)
+	limit := int(pagination.Size())
+	for obj := it.Next(); obj != nil && len(events) <= limit; obj = it.Next() {
+		event := obj.(*storage.AuditEvent)
+		if event.TenantID == tenantID && filter.Matches(*event) {
+			events = append(events, *event)
+		}
+	}
+
+	ct = database.NewEncodedContinuousToken("")
+	if len(events) > limit {
+		events = events[:limit]
+		if len(events) > 0 {
+			ct = database.NewContinuousToken(storage.AuditOrder, "", events[len(events)-1].ID).Encode()
+		}
+	}
+
+	return events, ct, nil
+}
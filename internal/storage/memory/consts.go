@@ -0,0 +1,10 @@
+package memory
+
+const (
+	UsersTable           = "users"
+	TenantsTable         = "tenants"
+	IdempotencyKeysTable = "idempotency_keys"
+	UserEventsTable      = "user_events"
+	AuditEventsTable     = "audit_events"
+	ApiKeysTable         = "api_keys"
+)
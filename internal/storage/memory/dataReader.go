@@ -0,0 +1,248 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// DataReader is responsible for reading data from the in-memory database.
+type DataReader struct {
+	database *db.Memory
+}
+
+// NewDataReader is a constructor function for DataReader.
+func NewDataReader(database *db.Memory) *DataReader {
+	return &DataReader{
+		database: database,
+	}
+}
+
+// ReadUsers reads the users matching the filter from the storage using the same ordering and pagination
+// semantics as the postgres implementation: a continuous token locates the page by the ordered field and id,
+// otherwise page numbers are used.
+func (r *DataReader) ReadUsers(ctx context.Context, filter storage.UserFilter, pagination database.Pagination) (users []*basev1.User, ct database.EncodedContinuousToken, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.read-users")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying users")
+
+	order := pagination.Order()
+	if order.Field == "" {
+		order = storage.DefaultUserOrder
+	}
+	if !slices.Contains(storage.UserOrderFields, order.Field) {
+		return nil, database.NewEncodedContinuousToken(""), apierrors.Newf(basev1.ErrorCode_ERROR_CODE_VALIDATION, "users can not be ordered by %s", order.Field)
+	}
+
+	var after *storage.User
+	var offset uint64
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = database.NewEncodedContinuousToken(pagination.Token()).Decode(order)
+		if err != nil {
+			return nil, database.NewEncodedContinuousToken(""), apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+		}
+		after = &storage.User{ID: t.ID, Name: t.Value}
+		if order.Field == "created_at" {
+			after.CreatedAt, err = time.Parse(time.RFC3339Nano, t.Value)
+			if err != nil {
+				return nil, database.NewEncodedContinuousToken(""), apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+			}
+		}
+	} else {
+		offset = uint64(pagination.Size()) * uint64(max(1, pagination.Page())-1)
+	}
+
+	var matched []*storage.User
+	matched, err = r.filterUsers(tenancy.ID(ctx), filter)
+	if err != nil {
+		return nil, database.NewEncodedContinuousToken(""), err
+	}
+
+	slices.SortFunc(matched, func(a, b *storage.User) int {
+		return compareUsers(a, b, order)
+	})
+
+	limit := uint64(pagination.Size())
+
+	var fetched []*storage.User
+	var skipped uint64
+	for _, user := range matched {
+		if uint64(len(fetched)) > limit {
+			break
+		}
+		if after != nil && compareUsers(user, after, order) <= 0 {
+			continue
+		}
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		fetched = append(fetched, user)
+	}
+
+	ct = database.NewEncodedContinuousToken("")
+	if uint64(len(fetched)) > limit {
+		fetched = fetched[:limit]
+		if len(fetched) > 0 {
+			last := fetched[len(fetched)-1]
+			ct = database.NewContinuousToken(order, last.OrderValue(order.Field), last.ID).Encode()
+		}
+	}
+
+	for _, user := range fetched {
+		users = append(users, user.ToProto())
+	}
+
+	slog.DebugContext(ctx, "successfully retrieved users from the memory database")
+
+	return users, ct, nil
+}
+
+// CountUsers counts the users matching the filter.
+func (r *DataReader) CountUsers(ctx context.Context, filter storage.UserFilter) (count int64, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.count-users")
+	defer span.End()
+
+	slog.DebugContext(ctx, "counting users")
+
+	matched, err := r.filterUsers(tenancy.ID(ctx), filter)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(matched)), nil
+}
+
+// ReadUser reads a single user from the storage by its id, soft deleted users are not found.
+func (r *DataReader) ReadUser(ctx context.Context, id uint64) (user *basev1.User, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.read-user")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying user", slog.Uint64("id", id))
+
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	var raw interface{}
+	raw, err = txn.First(UsersTable, "id", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+	if raw == nil || raw.(*storage.User).TenantID != tenancy.ID(ctx) || raw.(*storage.User).Deleted() {
+		return nil, apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND)
+	}
+
+	return raw.(*storage.User).ToProto(), nil
+}
+
+// ReadUsersByIDs reads the users with the given ids from the storage, soft deleted users are left out.
+func (r *DataReader) ReadUsersByIDs(ctx context.Context, ids []uint64) (users []*basev1.User, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.read-users-by-ids")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying users by ids", slog.Int("count", len(ids)))
+
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	tenantID := tenancy.ID(ctx)
+	for _, id := range ids {
+		var raw interface{}
+		raw, err = txn.First(UsersTable, "id", id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query user: %w", err)
+		}
+		if raw == nil || raw.(*storage.User).TenantID != tenantID || raw.(*storage.User).Deleted() {
+			continue
+		}
+		users = append(users, raw.(*storage.User).ToProto())
+	}
+
+	return users, nil
+}
+
+// ExportUsers calls fn for every user matching the filter in id order, the read transaction is a snapshot
+// so writes during the export are not seen.
+func (r *DataReader) ExportUsers(ctx context.Context, filter storage.UserFilter, fn func(user *basev1.User) error) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.export-users")
+	defer span.End()
+
+	slog.DebugContext(ctx, "exporting users")
+
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get(UsersTable, "id")
+	if err != nil {
+		return fmt.Errorf("failed to query users: %w", err)
+	}
+
+	tenantID := tenancy.ID(ctx)
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		user := obj.(*storage.User)
+		if user.TenantID != tenantID || !filter.Matches(*user) {
+			continue
+		}
+		if err = fn(user.ToProto()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// filterUsers returns all users of the tenant matching the filter.
+func (r *DataReader) filterUsers(tenantID uint64, filter storage.UserFilter) ([]*storage.User, error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get(UsersTable, "id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users: %w", err)
+	}
+
+	var matched []*storage.User
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		user := obj.(*storage.User)
+		if user.TenantID == tenantID && filter.Matches(*user) {
+			matched = append(matched, user)
+		}
+	}
+	return matched, nil
+}
+
+// compareUsers orders users by the ordered field, ties are broken by id in the same direction.
+func compareUsers(a, b *storage.User, order database.Order) int {
+	var c int
+	switch order.Field {
+	case "name":
+		c = strings.Compare(a.Name, b.Name)
+	case "created_at":
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if c == 0 {
+		c = cmp.Compare(a.ID, b.ID)
+	}
+	if order.Desc {
+		return -c
+	}
+	return c
+}
@@ -0,0 +1,303 @@
+package memory
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	MMDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+var _ = Describe("DataReader", func() {
+	var db *MMDatabase.Memory
+	var dataWriter *DataWriter
+	var dataReader *DataReader
+
+	BeforeEach(func() {
+		var err error
+		db, err = MMDatabase.New(Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(Seed(db)).Should(Succeed())
+
+		dataWriter = NewDataWriter(db)
+		dataReader = NewDataReader(db)
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Read Users", func() {
+		It("success", func() {
+			ctx := context.Background()
+
+			_, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			users, _, err := dataReader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(1), database.Page(1)))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(users[0].Id).Should(Equal(uint64(1)))
+			Expect(users[0].Name).Should(Equal("user-1"))
+		})
+
+		It("paginates newest first", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"user-1", "user-2", "user-3"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			users, _, err := dataReader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(2), database.Page(1)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(2))
+			Expect(users[0].Name).Should(Equal("user-3"))
+			Expect(users[1].Name).Should(Equal("user-2"))
+
+			users, _, err = dataReader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(2), database.Page(2)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(1))
+			Expect(users[0].Name).Should(Equal("user-1"))
+		})
+
+		It("paginates with continuous token", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"user-1", "user-2", "user-3"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			users, ct, err := dataReader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(2)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(2))
+			Expect(users[1].Name).Should(Equal("user-2"))
+			Expect(ct.String()).ShouldNot(BeEmpty())
+
+			users, ct, err = dataReader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(2), database.Token(ct.String())))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(1))
+			Expect(users[0].Name).Should(Equal("user-1"))
+			Expect(ct.String()).Should(BeEmpty())
+		})
+
+		It("filters by name", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"alice", "Alina", "bob", "al_x"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			users, _, err := dataReader.ReadUsers(ctx, storage.UserFilter{NamePrefix: "AL"}, database.NewPagination(database.Size(10), database.OrderBy(database.Order{Field: "id"})))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(3))
+			Expect(users[0].Name).Should(Equal("alice"))
+			Expect(users[1].Name).Should(Equal("Alina"))
+
+			// wildcards in the search text are matched literally
+			users, _, err = dataReader.ReadUsers(ctx, storage.UserFilter{NameContains: "_"}, database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(1))
+			Expect(users[0].Name).Should(Equal("al_x"))
+
+			count, err := dataReader.CountUsers(ctx, storage.UserFilter{NameContains: "li"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(int64(2)))
+		})
+
+		It("filters by creation time", func() {
+			ctx := context.Background()
+
+			first, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			second, err := dataWriter.Write(ctx, "ext-2", "user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			users, _, err := dataReader.ReadUsers(ctx, storage.UserFilter{CreatedAfter: second.CreatedAt.AsTime()}, database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(1))
+			Expect(users[0].Id).Should(Equal(second.Id))
+
+			users, _, err = dataReader.ReadUsers(ctx, storage.UserFilter{CreatedBefore: second.CreatedAt.AsTime()}, database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(1))
+			Expect(users[0].Id).Should(Equal(first.Id))
+		})
+
+		It("sorts and paginates by name", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"carol", "alice", "bob", "alice"} {
+				_, err := dataWriter.Write(ctx, "", name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			order := database.Order{Field: "name", Desc: true}
+
+			users, ct, err := dataReader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(3), database.OrderBy(order)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(3))
+			Expect(users[0].Name).Should(Equal("carol"))
+			Expect(users[1].Name).Should(Equal("bob"))
+			Expect(users[2].Name).Should(Equal("alice"))
+			Expect(users[2].Id).Should(Equal(uint64(4)))
+
+			users, ct, err = dataReader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(3), database.OrderBy(order), database.Token(ct.String())))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(1))
+			Expect(users[0].Id).Should(Equal(uint64(2)))
+			Expect(ct.String()).Should(BeEmpty())
+		})
+
+		It("rejects tokens of another order", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"user-1", "user-2"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			_, ct, err := dataReader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(1)))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = dataReader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(1), database.OrderBy(database.Order{Field: "id"}), database.Token(ct.String())))
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String()))
+		})
+
+		It("invalid continuous token", func() {
+			ctx := context.Background()
+
+			_, _, err := dataReader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(2), database.Token("invalid")))
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String()))
+		})
+	})
+
+	Context("Read Users By IDs", func() {
+		It("leaves out missing and deleted users", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"user-1", "user-2", "user-3"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			err := dataWriter.Delete(ctx, 2, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			users, err := dataReader.ReadUsersByIDs(ctx, []uint64{3, 2, 1, 42})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(2))
+
+			var names []string
+			for _, user := range users {
+				names = append(names, user.Name)
+			}
+			Expect(names).Should(ConsistOf("user-1", "user-3"))
+		})
+	})
+
+	Context("Export Users", func() {
+		It("exports live users in id order", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"user-1", "user-2", "user-3"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			err := dataWriter.Delete(ctx, 2, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var ids []uint64
+			err = dataReader.ExportUsers(ctx, storage.UserFilter{}, func(user *base.User) error {
+				ids = append(ids, user.Id)
+				return nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ids).Should(Equal([]uint64{1, 3}))
+
+			ids = nil
+			err = dataReader.ExportUsers(ctx, storage.UserFilter{ShowDeleted: true}, func(user *base.User) error {
+				ids = append(ids, user.Id)
+				return nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ids).Should(Equal([]uint64{1, 2, 3}))
+		})
+
+		It("stops when the callback fails", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"user-1", "user-2"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			calls := 0
+			err := dataReader.ExportUsers(ctx, storage.UserFilter{}, func(user *base.User) error {
+				calls++
+				return context.Canceled
+			})
+			Expect(err).Should(MatchError(context.Canceled))
+			Expect(calls).Should(Equal(1))
+		})
+	})
+
+	Context("Read User", func() {
+		It("success", func() {
+			ctx := context.Background()
+
+			_, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			user, err := dataReader.ReadUser(ctx, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(user.Id).Should(Equal(uint64(1)))
+			Expect(user.Name).Should(Equal("user-1"))
+		})
+
+		It("not found", func() {
+			ctx := context.Background()
+
+			_, err := dataReader.ReadUser(ctx, 1)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND.String()))
+		})
+	})
+
+	Context("Tenants", func() {
+		It("only reads the users of the tenant in the context", func() {
+			tenant, err := NewTenantStore(db).Create(context.Background(), "acme")
+			Expect(err).ShouldNot(HaveOccurred())
+			acme := tenancy.NewContext(context.Background(), tenant.GetId())
+
+			own, err := dataWriter.Write(context.Background(), "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			other, err := dataWriter.Write(acme, "ext-1", "user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(other.GetTenantId()).Should(Equal(tenant.GetId()))
+
+			users, _, err := dataReader.ReadUsers(acme, storage.UserFilter{}, database.NewPagination(database.Size(10), database.Page(1)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(1))
+			Expect(users[0].GetName()).Should(Equal("user-2"))
+
+			_, err = dataReader.ReadUser(acme, own.GetId())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND.String()))
+
+			found, err := dataReader.ReadUsersByIDs(context.Background(), []uint64{own.GetId(), other.GetId()})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(found).Should(HaveLen(1))
+			Expect(found[0].GetId()).Should(Equal(own.GetId()))
+		})
+	})
+})
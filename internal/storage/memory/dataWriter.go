@@ -0,0 +1,331 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// DataWriter - Structure for in-memory Data Writer
+type DataWriter struct {
+	database *db.Memory
+}
+
+func NewDataWriter(database *db.Memory) *DataWriter {
+	return &DataWriter{
+		database: database,
+	}
+}
+
+// Write creates a new user, assigning it the next id from the database's record counter.
+// An external id that is already taken yields ERROR_CODE_ALREADY_EXIST.
+func (w *DataWriter) Write(ctx context.Context, externalID, name string) (user *basev1.User, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.write")
+	defer span.End()
+
+	slog.DebugContext(ctx, "write user")
+
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	tenantID := tenancy.ID(ctx)
+	if err = tenantExists(txn, tenantID); err != nil {
+		return nil, err
+	}
+
+	if externalID != "" {
+		var existing interface{}
+		existing, err = txn.First(UsersTable, "external_id", tenantID, externalID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query user: %w", err)
+		}
+		if existing != nil {
+			return nil, apierrors.New(basev1.ErrorCode_ERROR_CODE_ALREADY_EXIST).WithMetadata("external_id", externalID)
+		}
+	}
+
+	created := &storage.User{
+		ID:         w.database.NextRID(),
+		TenantID:   tenantID,
+		ExternalID: externalID,
+		Name:       name,
+		CreatedAt:  time.Now().UTC(),
+		Revision:   1,
+	}
+
+	if err = txn.Insert(UsersTable, created); err != nil {
+		return nil, fmt.Errorf("failed to insert user: %w", err)
+	}
+	if err = w.record(txn, storage.UserCreated, created); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+
+	slog.DebugContext(ctx, "successfully written user to the memory database")
+	return created.ToProto(), nil
+}
+
+// WriteBatch creates the users in one transaction. Without partial the transaction is aborted at the first
+// failing user, with partial failing users are skipped and reported in their results.
+func (w *DataWriter) WriteBatch(ctx context.Context, users []storage.User, partial bool) (results []storage.UserResult, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.write-batch")
+	defer span.End()
+
+	slog.DebugContext(ctx, "write users", slog.Int("count", len(users)))
+
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	tenantID := tenancy.ID(ctx)
+	if err = tenantExists(txn, tenantID); err != nil {
+		return nil, err
+	}
+
+	results = make([]storage.UserResult, len(users))
+	for i, u := range users {
+		if u.ExternalID != "" {
+			var existing interface{}
+			existing, err = txn.First(UsersTable, "external_id", tenantID, u.ExternalID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query user: %w", err)
+			}
+			if existing != nil {
+				e := apierrors.New(basev1.ErrorCode_ERROR_CODE_ALREADY_EXIST).WithMetadata("external_id", u.ExternalID)
+				if !partial {
+					return nil, e.WithMetadata("index", strconv.Itoa(i))
+				}
+				results[i].Err = e
+				continue
+			}
+		}
+
+		created := &storage.User{
+			ID:         w.database.NextRID(),
+			TenantID:   tenantID,
+			ExternalID: u.ExternalID,
+			Name:       u.Name,
+			CreatedAt:  time.Now().UTC(),
+			Revision:   1,
+		}
+		if err = txn.Insert(UsersTable, created); err != nil {
+			return nil, fmt.Errorf("failed to insert user: %w", err)
+		}
+		if err = w.record(txn, storage.UserCreated, created); err != nil {
+			return nil, err
+		}
+		results[i].User = created.ToProto()
+	}
+
+	txn.Commit()
+
+	slog.DebugContext(ctx, "successfully written users to the memory database")
+	return results, nil
+}
+
+// Update changes the name of an existing user and returns the updated user, soft deleted users are not found.
+// A non-zero revision must match the current revision of the user.
+func (w *DataWriter) Update(ctx context.Context, id uint64, name string, revision uint64) (user *basev1.User, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.update")
+	defer span.End()
+
+	slog.DebugContext(ctx, "update user", slog.Uint64("id", id))
+
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	var raw interface{}
+	raw, err = txn.First(UsersTable, "id", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+	if err = matchRevision(raw, tenancy.ID(ctx), revision); err != nil {
+		return nil, err
+	}
+
+	// Objects stored in memdb must not be modified in place, so insert an updated copy.
+	updated := *raw.(*storage.User)
+	updated.Name = name
+	updated.Revision++
+
+	if err = txn.Insert(UsersTable, &updated); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+	if err = w.record(txn, storage.UserUpdated, &updated); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+
+	slog.DebugContext(ctx, "successfully updated user in the memory database")
+	return updated.ToProto(), nil
+}
+
+// Delete soft deletes an existing user by setting its DeletedAt, deleting a deleted user yields ERROR_CODE_NOT_FOUND.
+// A non-zero revision must match the current revision of the user.
+func (w *DataWriter) Delete(ctx context.Context, id uint64, revision uint64) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.delete")
+	defer span.End()
+
+	slog.DebugContext(ctx, "delete user", slog.Uint64("id", id))
+
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	var raw interface{}
+	raw, err = txn.First(UsersTable, "id", id)
+	if err != nil {
+		return fmt.Errorf("failed to query user: %w", err)
+	}
+	if err = matchRevision(raw, tenancy.ID(ctx), revision); err != nil {
+		return err
+	}
+
+	deleted := *raw.(*storage.User)
+	deleted.DeletedAt = time.Now().UTC()
+	deleted.Revision++
+
+	if err = txn.Insert(UsersTable, &deleted); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	if err = w.record(txn, storage.UserDeleted, &deleted); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	slog.DebugContext(ctx, "successfully deleted user from the memory database")
+	return nil
+}
+
+// Undelete clears the DeletedAt of a user and returns it, restoring a user that is not deleted returns it unchanged.
+func (w *DataWriter) Undelete(ctx context.Context, id uint64) (user *basev1.User, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.undelete")
+	defer span.End()
+
+	slog.DebugContext(ctx, "undelete user", slog.Uint64("id", id))
+
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	var raw interface{}
+	raw, err = txn.First(UsersTable, "id", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+	if raw == nil || raw.(*storage.User).TenantID != tenancy.ID(ctx) {
+		return nil, apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND)
+	}
+
+	restored := *raw.(*storage.User)
+	if !restored.Deleted() {
+		return restored.ToProto(), nil
+	}
+	restored.DeletedAt = time.Time{}
+	restored.Revision++
+
+	if err = txn.Insert(UsersTable, &restored); err != nil {
+		return nil, fmt.Errorf("failed to undelete user: %w", err)
+	}
+	if err = w.record(txn, storage.UserUndeleted, &restored); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+
+	slog.DebugContext(ctx, "successfully undeleted user in the memory database")
+	return restored.ToProto(), nil
+}
+
+// Purge permanently removes the users soft deleted before the given time.
+func (w *DataWriter) Purge(ctx context.Context, before time.Time) (purged int64, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.purge")
+	defer span.End()
+
+	slog.DebugContext(ctx, "purge users", slog.Time("before", before))
+
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	var it memdb.ResultIterator
+	it, err = txn.Get(UsersTable, "id")
+	if err != nil {
+		return 0, fmt.Errorf("failed to query users: %w", err)
+	}
+
+	// Collect first, deleting while iterating would invalidate the iterator.
+	var expired []*storage.User
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		user := obj.(*storage.User)
+		if user.Deleted() && user.DeletedAt.Before(before) {
+			expired = append(expired, user)
+		}
+	}
+
+	for _, user := range expired {
+		if err = txn.Delete(UsersTable, user); err != nil {
+			return 0, fmt.Errorf("failed to purge user: %w", err)
+		}
+	}
+
+	txn.Commit()
+
+	slog.DebugContext(ctx, "successfully purged users from the memory database", slog.Int("count", len(expired)))
+	return int64(len(expired)), nil
+}
+
+// record inserts the event of a change in the transaction making the change. Write transactions are
+// serialized by memdb, so event ids increase in the order the changes are committed.
+func (w *DataWriter) record(txn *memdb.Txn, typ storage.UserEventType, user *storage.User) error {
+	event := &storage.UserEvent{
+		ID:        w.database.NextEID(),
+		Type:      typ,
+		User:      user.ToProto(),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := txn.Insert(UserEventsTable, event); err != nil {
+		return fmt.Errorf("failed to insert user event: %w", err)
+	}
+	return nil
+}
+
+// matchRevision checks that the user exists in the tenant, is not soft deleted and, for a non-zero revision, has
+// that revision.
+func matchRevision(raw interface{}, tenantID, revision uint64) error {
+	if raw == nil || raw.(*storage.User).TenantID != tenantID || raw.(*storage.User).Deleted() {
+		return apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND)
+	}
+	if current := raw.(*storage.User).Revision; revision != 0 && revision != current {
+		return apierrors.New(basev1.ErrorCode_ERROR_CODE_CONFLICT).WithMetadata("revision", strconv.FormatUint(current, 10))
+	}
+	return nil
+}
+
+// tenantExists checks that users can be written to the tenant.
+func tenantExists(txn *memdb.Txn, tenantID uint64) error {
+	raw, err := txn.First(TenantsTable, "id", tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to query tenant: %w", err)
+	}
+	if raw == nil {
+		return apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND).WithMetadata("tenant_id", strconv.FormatUint(tenantID, 10))
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	MMDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+var _ = Describe("DataWriter", func() {
+	var db *MMDatabase.Memory
+	var dataWriter *DataWriter
+
+	BeforeEach(func() {
+		var err error
+		db, err = MMDatabase.New(Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(Seed(db)).Should(Succeed())
+
+		dataWriter = NewDataWriter(db)
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Write", func() {
+		It("success", func() {
+			ctx := context.Background()
+			user, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(user.Id).Should(Equal(uint64(1)))
+			Expect(user.ExternalId).Should(Equal("ext-1"))
+			Expect(user.Name).Should(Equal("user-1"))
+			Expect(user.CreatedAt).ShouldNot(BeNil())
+		})
+
+		It("already exist", func() {
+			ctx := context.Background()
+			_, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(ctx, "ext-1", "user-2")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_ALREADY_EXIST.String()))
+		})
+
+		It("without external id", func() {
+			ctx := context.Background()
+			_, err := dataWriter.Write(ctx, "", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(ctx, "", "user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("WriteBatch", func() {
+		It("creates all users", func() {
+			ctx := context.Background()
+			results, err := dataWriter.WriteBatch(ctx, []storage.User{{ExternalID: "ext-1", Name: "user-1"}, {Name: "user-2"}}, false)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(results).Should(HaveLen(2))
+			Expect(results[0].User.Name).Should(Equal("user-1"))
+			Expect(results[0].User.ExternalId).Should(Equal("ext-1"))
+			Expect(results[1].User.Name).Should(Equal("user-2"))
+		})
+
+		It("creates none when one fails", func() {
+			ctx := context.Background()
+			_, err := dataWriter.Write(ctx, "ext-2", "user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.WriteBatch(ctx, []storage.User{{ExternalID: "ext-1", Name: "user-1"}, {ExternalID: "ext-2", Name: "user-2"}}, false)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_ALREADY_EXIST.String()))
+			Expect(apierrors.FromError(err).Metadata).Should(HaveKeyWithValue("index", "1"))
+
+			count, err := NewDataReader(db).CountUsers(ctx, storage.UserFilter{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(int64(1)))
+		})
+
+		It("reports failing users with partial", func() {
+			ctx := context.Background()
+			_, err := dataWriter.Write(ctx, "ext-2", "user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			results, err := dataWriter.WriteBatch(ctx, []storage.User{
+				{ExternalID: "ext-1", Name: "user-1"},
+				{ExternalID: "ext-2", Name: "user-2"},
+				{ExternalID: "ext-1", Name: "user-3"},
+				{ExternalID: "ext-4", Name: "user-4"},
+			}, true)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(results).Should(HaveLen(4))
+			Expect(results[0].User.Name).Should(Equal("user-1"))
+			Expect(results[1].Err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_ALREADY_EXIST.String()))
+			Expect(results[2].Err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_ALREADY_EXIST.String()))
+			Expect(results[3].User.Name).Should(Equal("user-4"))
+
+			count, err := NewDataReader(db).CountUsers(ctx, storage.UserFilter{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(int64(3)))
+		})
+	})
+
+	Context("Update", func() {
+		It("success", func() {
+			ctx := context.Background()
+			_, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			user, err := dataWriter.Update(ctx, 1, "user-2", 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(user.Id).Should(Equal(uint64(1)))
+			Expect(user.Name).Should(Equal("user-2"))
+		})
+
+		It("not found", func() {
+			ctx := context.Background()
+			_, err := dataWriter.Update(ctx, 1, "user-2", 0)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND.String()))
+		})
+
+		It("checks the revision", func() {
+			ctx := context.Background()
+			created, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(created.Revision).Should(Equal(uint64(1)))
+			Expect(created.Etag).Should(Equal(`"1"`))
+
+			user, err := dataWriter.Update(ctx, 1, "user-2", 1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(user.Revision).Should(Equal(uint64(2)))
+
+			_, err = dataWriter.Update(ctx, 1, "user-3", 1)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_CONFLICT.String()))
+			Expect(apierrors.FromError(err).Metadata).Should(HaveKeyWithValue("revision", "2"))
+
+			err = dataWriter.Delete(ctx, 1, 1)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_CONFLICT.String()))
+
+			err = dataWriter.Delete(ctx, 1, 2)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			user, err = dataWriter.Undelete(ctx, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(user.Revision).Should(Equal(uint64(4)))
+		})
+	})
+
+	Context("Delete", func() {
+		It("success", func() {
+			ctx := context.Background()
+			_, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = dataWriter.Delete(ctx, 1, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("not found", func() {
+			ctx := context.Background()
+			err := dataWriter.Delete(ctx, 1, 0)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND.String()))
+		})
+		It("hides the deleted user", func() {
+			ctx := context.Background()
+			_, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = dataWriter.Delete(ctx, 1, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			dataReader := NewDataReader(db)
+			_, err = dataReader.ReadUser(ctx, 1)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND.String()))
+
+			users, _, err := dataReader.ReadUsers(ctx, storage.UserFilter{}, database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(BeEmpty())
+
+			users, _, err = dataReader.ReadUsers(ctx, storage.UserFilter{ShowDeleted: true}, database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(users).Should(HaveLen(1))
+			Expect(users[0].DeletedAt).ShouldNot(BeNil())
+
+			_, err = dataWriter.Update(ctx, 1, "user-2", 0)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND.String()))
+
+			err = dataWriter.Delete(ctx, 1, 0)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND.String()))
+		})
+	})
+
+	Context("Undelete", func() {
+		It("success", func() {
+			ctx := context.Background()
+			_, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = dataWriter.Delete(ctx, 1, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			user, err := dataWriter.Undelete(ctx, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(user.Name).Should(Equal("user-1"))
+			Expect(user.DeletedAt).Should(BeNil())
+
+			_, err = NewDataReader(db).ReadUser(ctx, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("not found", func() {
+			ctx := context.Background()
+			_, err := dataWriter.Undelete(ctx, 1)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND.String()))
+		})
+	})
+
+	Context("Purge", func() {
+		It("removes only users deleted before the time", func() {
+			ctx := context.Background()
+			for _, name := range []string{"user-1", "user-2", "user-3"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			err := dataWriter.Delete(ctx, 1, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			purged, err := dataWriter.Purge(ctx, time.Now().UTC().Add(-time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(purged).Should(Equal(int64(0)))
+
+			purged, err = dataWriter.Purge(ctx, time.Now().UTC().Add(time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(purged).Should(Equal(int64(1)))
+
+			_, err = dataWriter.Undelete(ctx, 1)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND.String()))
+
+			count, err := NewDataReader(db).CountUsers(ctx, storage.UserFilter{ShowDeleted: true})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(int64(2)))
+		})
+	})
+})
@@ -0,0 +1,139 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+)
+
+// IdempotencyStore - Structure for in-memory Idempotency Store
+type IdempotencyStore struct {
+	database *db.Memory
+}
+
+func NewIdempotencyStore(database *db.Memory) *IdempotencyStore {
+	return &IdempotencyStore{
+		database: database,
+	}
+}
+
+// Reserve claims the key unless it is held by a record that has not expired yet.
+func (s *IdempotencyStore) Reserve(ctx context.Context, record storage.IdempotencyRecord) (existing *storage.IdempotencyRecord, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "idempotency-store.reserve")
+	defer span.End()
+
+	slog.DebugContext(ctx, "reserve idempotency key", slog.String("method", record.Method))
+
+	txn := s.database.DB.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(IdempotencyKeysTable, "id", record.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query idempotency key: %w", err)
+	}
+	if raw != nil && raw.(*storage.IdempotencyRecord).ExpiresAt.After(time.Now()) {
+		fnd := *raw.(*storage.IdempotencyRecord)
+		return &fnd, nil
+	}
+
+	record.Response = nil
+	record.CreatedAt = time.Now().UTC()
+	record.CompletedAt = time.Time{}
+	if err = txn.Insert(IdempotencyKeysTable, &record); err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	txn.Commit()
+	return nil, nil
+}
+
+// Complete stores the response of the request the key was reserved for.
+func (s *IdempotencyStore) Complete(ctx context.Context, key string, response []byte) (err error) {
+	// Start a new trace span and end it when the function exits.
+	_, span := internal.Tracer.Start(ctx, "idempotency-store.complete")
+	defer span.End()
+
+	txn := s.database.DB.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(IdempotencyKeysTable, "id", key)
+	if err != nil {
+		return fmt.Errorf("failed to query idempotency key: %w", err)
+	}
+	if raw == nil {
+		return nil
+	}
+
+	// Objects stored in memdb must not be modified in place, so insert an updated copy.
+	updated := *raw.(*storage.IdempotencyRecord)
+	updated.Response = response
+	updated.CompletedAt = time.Now().UTC()
+	if err = txn.Insert(IdempotencyKeysTable, &updated); err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// Release removes the reservation of a request that failed.
+func (s *IdempotencyStore) Release(ctx context.Context, key string) (err error) {
+	// Start a new trace span and end it when the function exits.
+	_, span := internal.Tracer.Start(ctx, "idempotency-store.release")
+	defer span.End()
+
+	txn := s.database.DB.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(IdempotencyKeysTable, "id", key)
+	if err != nil {
+		return fmt.Errorf("failed to query idempotency key: %w", err)
+	}
+	if raw == nil || !raw.(*storage.IdempotencyRecord).Pending() {
+		return nil
+	}
+
+	if err = txn.Delete(IdempotencyKeysTable, raw); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// DeleteExpired removes the keys that expired before the given time.
+func (s *IdempotencyStore) DeleteExpired(ctx context.Context, before time.Time) (deleted int64, err error) {
+	// Start a new trace span and end it when the function exits.
+	_, span := internal.Tracer.Start(ctx, "idempotency-store.delete-expired")
+	defer span.End()
+
+	txn := s.database.DB.Txn(true)
+	defer txn.Abort()
+
+	it, err := txn.Get(IdempotencyKeysTable, "id")
+	if err != nil {
+		return 0, fmt.Errorf("failed to query idempotency keys: %w", err)
+	}
+
+	var expired []interface{}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		if obj.(*storage.IdempotencyRecord).ExpiresAt.Before(before) {
+			expired = append(expired, obj)
+		}
+	}
+
+	for _, obj := range expired {
+		if err = txn.Delete(IdempotencyKeysTable, obj); err != nil {
+			return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+		}
+	}
+
+	txn.Commit()
+	return int64(len(expired)), nil
+}
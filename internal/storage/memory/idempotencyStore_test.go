@@ -0,0 +1,142 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	MMDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+)
+
+var _ = Describe("IdempotencyStore", func() {
+	var db *MMDatabase.Memory
+	var store *IdempotencyStore
+
+	record := func(key string, ttl time.Duration) storage.IdempotencyRecord {
+		return storage.IdempotencyRecord{
+			Key:         key,
+			Method:      "/base.v1.UserService/Create",
+			RequestHash: []byte("hash"),
+			ExpiresAt:   time.Now().Add(ttl),
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		db, err = MMDatabase.New(Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		store = NewIdempotencyStore(db)
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Reserve", func() {
+		It("reserves a new key", func() {
+			ctx := context.Background()
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).Should(BeNil())
+		})
+
+		It("returns the pending record of a reserved key", func() {
+			ctx := context.Background()
+
+			_, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).ShouldNot(BeNil())
+			Expect(existing.Pending()).Should(BeTrue())
+			Expect(existing.RequestHash).Should(Equal([]byte("hash")))
+		})
+
+		It("returns the stored response of a completed key", func() {
+			ctx := context.Background()
+
+			_, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(store.Complete(ctx, "key-1", []byte("response"))).Should(Succeed())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).ShouldNot(BeNil())
+			Expect(existing.Response).Should(Equal([]byte("response")))
+		})
+
+		It("returns the empty response of a completed key", func() {
+			ctx := context.Background()
+
+			_, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(store.Complete(ctx, "key-1", nil)).Should(Succeed())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).ShouldNot(BeNil())
+			Expect(existing.Pending()).Should(BeFalse())
+			Expect(existing.Response).Should(BeEmpty())
+		})
+
+		It("reserves an expired key again", func() {
+			ctx := context.Background()
+
+			_, err := store.Reserve(ctx, record("key-1", -time.Minute))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).Should(BeNil())
+		})
+	})
+
+	Context("Release", func() {
+		It("frees a pending key", func() {
+			ctx := context.Background()
+
+			_, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(store.Release(ctx, "key-1")).Should(Succeed())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).Should(BeNil())
+		})
+
+		It("keeps a completed key", func() {
+			ctx := context.Background()
+
+			_, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(store.Complete(ctx, "key-1", []byte("response"))).Should(Succeed())
+			Expect(store.Release(ctx, "key-1")).Should(Succeed())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).ShouldNot(BeNil())
+		})
+	})
+
+	Context("DeleteExpired", func() {
+		It("deletes only expired keys", func() {
+			ctx := context.Background()
+
+			_, err := store.Reserve(ctx, record("key-1", -time.Minute))
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = store.Reserve(ctx, record("key-2", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			deleted, err := store.DeleteExpired(ctx, time.Now())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(Equal(int64(1)))
+		})
+	})
+})
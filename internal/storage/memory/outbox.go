@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+)
+
+// Outbox - Structure for in-memory Outbox
+type Outbox struct {
+	database *db.Memory
+	// relaying is held by the one relay publishing user events at a time
+	relaying sync.Mutex
+}
+
+func NewOutbox(database *db.Memory) *Outbox {
+	return &Outbox{
+		database: database,
+	}
+}
+
+// Relay publishes the pending user events in order and marks the ones fn accepted.
+func (o *Outbox) Relay(ctx context.Context, limit int, fn func(event storage.UserEvent) error) (published int, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "outbox.relay")
+	defer span.End()
+
+	if !o.relaying.TryLock() {
+		slog.DebugContext(ctx, "another relay is publishing user events")
+		return 0, nil
+	}
+	defer o.relaying.Unlock()
+
+	pending, err := o.pending(limit)
+	if err != nil {
+		return 0, err
+	}
+
+	// The events accepted before a failure are still marked, the failing one is retried by the next relay.
+	var failure error
+	accepted := make([]*storage.UserEvent, 0, len(pending))
+	for _, event := range pending {
+		if failure = fn(*event); failure != nil {
+			break
+		}
+		accepted = append(accepted, event)
+	}
+
+	if len(accepted) > 0 {
+		txn := o.database.DB.Txn(true)
+		defer txn.Abort()
+
+		now := time.Now().UTC()
+		for _, event := range accepted {
+			// Objects stored in memdb must not be modified in place, so insert a marked copy.
+			marked := *event
+			marked.PublishedAt = now
+			if err = txn.Insert(UserEventsTable, &marked); err != nil {
+				return 0, fmt.Errorf("failed to mark user event: %w", err)
+			}
+		}
+
+		txn.Commit()
+	}
+
+	slog.DebugContext(ctx, "successfully relayed user events", slog.Int("count", len(accepted)))
+	return len(accepted), failure
+}
+
+// pending returns at most limit user events that were not published yet, in id order.
+func (o *Outbox) pending(limit int) ([]*storage.UserEvent, error) {
+	txn := o.database.DB.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get(UserEventsTable, "id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user events: %w", err)
+	}
+
+	var pending []*storage.UserEvent
+	for obj := it.Next(); obj != nil && len(pending) < limit; obj = it.Next() {
+		if event := obj.(*storage.UserEvent); !event.Published() {
+			pending = append(pending, event)
+		}
+	}
+	return pending, nil
+}
+
+// Cleanup removes the user events published before the given time except the latest one.
+func (o *Outbox) Cleanup(ctx context.Context, before time.Time) (deleted int64, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "outbox.cleanup")
+	defer span.End()
+
+	slog.DebugContext(ctx, "cleanup user events", slog.Time("before", before))
+
+	txn := o.database.DB.Txn(true)
+	defer txn.Abort()
+
+	latest, err := txn.Last(UserEventsTable, "id")
+	if err != nil {
+		return 0, fmt.Errorf("failed to query user events: %w", err)
+	}
+	if latest == nil {
+		return 0, nil
+	}
+
+	var it memdb.ResultIterator
+	it, err = txn.Get(UserEventsTable, "id")
+	if err != nil {
+		return 0, fmt.Errorf("failed to query user events: %w", err)
+	}
+
+	// Collect first, deleting while iterating would invalidate the iterator.
+	var expired []*storage.UserEvent
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		event := obj.(*storage.UserEvent)
+		if event != latest && event.Published() && event.PublishedAt.Before(before) {
+			expired = append(expired, event)
+		}
+	}
+
+	for _, event := range expired {
+		if err = txn.Delete(UserEventsTable, event); err != nil {
+			return 0, fmt.Errorf("failed to cleanup user event: %w", err)
+		}
+	}
+
+	txn.Commit()
+
+	slog.DebugContext(ctx, "successfully cleaned up user events", slog.Int("count", len(expired)))
+	return int64(len(expired)), nil
+}
@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	MMDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+)
+
+var _ = Describe("Outbox", func() {
+	var db *MMDatabase.Memory
+	var dataWriter *DataWriter
+	var outbox *Outbox
+
+	BeforeEach(func() {
+		var err error
+		db, err = MMDatabase.New(Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(Seed(db)).Should(Succeed())
+
+		dataWriter = NewDataWriter(db)
+		outbox = NewOutbox(db)
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Relay", func() {
+		It("publishes pending events once and in order", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"user-1", "user-2", "user-3"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			var ids []uint64
+			publish := func(event storage.UserEvent) error {
+				ids = append(ids, event.ID)
+				return nil
+			}
+
+			published, err := outbox.Relay(ctx, 2, publish)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(published).Should(Equal(2))
+
+			published, err = outbox.Relay(ctx, 2, publish)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(published).Should(Equal(1))
+
+			published, err = outbox.Relay(ctx, 2, publish)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(published).Should(Equal(0))
+
+			Expect(ids).Should(Equal([]uint64{1, 2, 3}))
+		})
+
+		It("keeps the events from a failure on pending", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"user-1", "user-2", "user-3"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			failure := errors.New("unavailable")
+			published, err := outbox.Relay(ctx, 10, func(event storage.UserEvent) error {
+				if event.ID == 2 {
+					return failure
+				}
+				return nil
+			})
+			Expect(err).Should(MatchError(failure))
+			Expect(published).Should(Equal(1))
+
+			var ids []uint64
+			_, err = outbox.Relay(ctx, 10, func(event storage.UserEvent) error {
+				ids = append(ids, event.ID)
+				return nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ids).Should(Equal([]uint64{2, 3}))
+		})
+	})
+
+	Context("Cleanup", func() {
+		It("removes published events except the latest", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"user-1", "user-2", "user-3"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			_, err := outbox.Relay(ctx, 2, func(storage.UserEvent) error {
+				return nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			deleted, err := outbox.Cleanup(ctx, time.Now().Add(-time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(Equal(int64(0)))
+
+			// the third event is not published yet
+			deleted, err = outbox.Cleanup(ctx, time.Now().Add(time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(Equal(int64(2)))
+
+			_, err = outbox.Relay(ctx, 2, func(storage.UserEvent) error {
+				return nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			deleted, err = outbox.Cleanup(ctx, time.Now().Add(time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(Equal(int64(0)))
+
+			watcher := NewWatcher(db)
+			head, err := watcher.Head(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(uint64(3)))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"github.com/hashicorp/go-memdb"
+)
+
+// Schema is the in-memory database schema, it mirrors the tables created by the postgres migrations.
+var Schema = &memdb.DBSchema{
+	Tables: map[string]*memdb.TableSchema{
+		UsersTable: {
+			Name: UsersTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.UintFieldIndex{Field: "ID"},
+				},
+				// External ids are unique within a tenant, users without one are left out of the index.
+				"external_id": {
+					Name:         "external_id",
+					Unique:       true,
+					AllowMissing: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.UintFieldIndex{Field: "TenantID"},
+							&memdb.StringFieldIndex{Field: "ExternalID"},
+						},
+					},
+				},
+			},
+		},
+		TenantsTable: {
+			Name: TenantsTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.UintFieldIndex{Field: "ID"},
+				},
+				"name": {
+					Name:    "name",
+					Unique:  true,
+					Indexer: &memdb.StringFieldIndex{Field: "Name"},
+				},
+			},
+		},
+		UserEventsTable: {
+			Name: UserEventsTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.UintFieldIndex{Field: "ID"},
+				},
+			},
+		},
+		AuditEventsTable: {
+			Name: AuditEventsTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.UintFieldIndex{Field: "ID"},
+				},
+			},
+		},
+		ApiKeysTable: {
+			Name: ApiKeysTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.UintFieldIndex{Field: "ID"},
+				},
+				"hash": {
+					Name:    "hash",
+					Unique:  true,
+					Indexer: &memdb.StringFieldIndex{Field: "Hash"},
+				},
+			},
+		},
+		IdempotencyKeysTable: {
+			Name: IdempotencyKeysTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.StringFieldIndex{Field: "Key"},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,227 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// defaultTenantName is the name of the tenant created by Seed.
+const defaultTenantName = "default"
+
+// TenantStore - Structure for in-memory Tenant Store
+type TenantStore struct {
+	database *db.Memory
+}
+
+func NewTenantStore(database *db.Memory) *TenantStore {
+	return &TenantStore{
+		database: database,
+	}
+}
+
+// Seed creates the default tenant of a new database, like the postgres migrations do. It takes the first
+// tenant id, which is tenancy.DefaultTenant.
+func Seed(database *db.Memory) error {
+	txn := database.DB.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(TenantsTable, "name", defaultTenantName)
+	if err != nil {
+		return fmt.Errorf("failed to query tenant: %w", err)
+	}
+	if raw != nil {
+		return nil
+	}
+
+	tenant := &storage.Tenant{
+		ID:        database.NextTID(),
+		Name:      defaultTenantName,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err = txn.Insert(TenantsTable, tenant); err != nil {
+		return fmt.Errorf("failed to insert tenant: %w", err)
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// Create creates a tenant, assigning it the next id from the database's tenant counter.
+func (s *TenantStore) Create(ctx context.Context, name string) (tenant *basev1.Tenant, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "tenant-store.create")
+	defer span.End()
+
+	slog.DebugContext(ctx, "create tenant", slog.String("name", name))
+
+	txn := s.database.DB.Txn(true)
+	defer txn.Abort()
+
+	// memdb does not enforce unique indexes other than id, so the name is checked first.
+	existing, err := txn.First(TenantsTable, "name", name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tenant: %w", err)
+	}
+	if existing != nil {
+		return nil, apierrors.New(basev1.ErrorCode_ERROR_CODE_ALREADY_EXIST).WithMetadata("name", name)
+	}
+
+	created := &storage.Tenant{
+		ID:        s.database.NextTID(),
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err = txn.Insert(TenantsTable, created); err != nil {
+		return nil, fmt.Errorf("failed to insert tenant: %w", err)
+	}
+
+	txn.Commit()
+	return created.ToProto(), nil
+}
+
+// List walks the tenants in id order, starting after the id of the token.
+func (s *TenantStore) List(ctx context.Context, pagination database.Pagination) (tenants []*basev1.Tenant, ct database.EncodedContinuousToken, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "tenant-store.list")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying tenants")
+
+	txn := s.database.DB.Txn(false)
+	defer txn.Abort()
+
+	var after uint64
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = database.NewEncodedContinuousToken(pagination.Token()).Decode(storage.TenantOrder)
+		if err != nil {
+			return nil, database.NewEncodedContinuousToken(""), apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+		}
+		after = t.ID
+	}
+
+	it, err := txn.LowerBound(TenantsTable, "id", after+1)
+	if err != nil {
+		return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("failed to query tenants: %w", err)
+	}
+
+	limit := int(pagination.Size())
+	var fetched []*storage.Tenant
+	for obj := it.Next(); obj != nil && len(fetched) <= limit; obj = it.Next() {
+		fetched = append(fetched, obj.(*storage.Tenant))
+	}
+
+	ct = database.NewEncodedContinuousToken("")
+	if len(fetched) > limit {
+		fetched = fetched[:limit]
+		if len(fetched) > 0 {
+			ct = database.NewContinuousToken(storage.TenantOrder, "", fetched[len(fetched)-1].ID).Encode()
+		}
+	}
+
+	for _, tenant := range fetched {
+		tenants = append(tenants, tenant.ToProto())
+	}
+
+	return tenants, ct, nil
+}
+
+// Delete removes the tenant, its users, their events and its api keys in one transaction.
+func (s *TenantStore) Delete(ctx context.Context, id uint64) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "tenant-store.delete")
+	defer span.End()
+
+	slog.DebugContext(ctx, "delete tenant", slog.Uint64("id", id))
+
+	// Data written without a tenant belongs to the default one, it has to outlive every other tenant.
+	if id == tenancy.DefaultTenant {
+		return apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT).WithMessage("the default tenant can not be deleted")
+	}
+
+	txn := s.database.DB.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(TenantsTable, "id", id)
+	if err != nil {
+		return fmt.Errorf("failed to query tenant: %w", err)
+	}
+	if raw == nil {
+		return apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND).WithMetadata("tenant_id", strconv.FormatUint(id, 10))
+	}
+
+	users, err := collect(txn, UsersTable, func(obj interface{}) bool {
+		return obj.(*storage.User).TenantID == id
+	})
+	if err != nil {
+		return err
+	}
+	events, err := collect(txn, UserEventsTable, func(obj interface{}) bool {
+		return obj.(*storage.UserEvent).User.GetTenantId() == id
+	})
+	if err != nil {
+		return err
+	}
+
+	keys, err := collect(txn, ApiKeysTable, func(obj interface{}) bool {
+		return obj.(*storage.ApiKey).TenantID == id
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range users {
+		if err = txn.Delete(UsersTable, obj); err != nil {
+			return fmt.Errorf("failed to delete user of tenant: %w", err)
+		}
+	}
+	for _, obj := range events {
+		if err = txn.Delete(UserEventsTable, obj); err != nil {
+			return fmt.Errorf("failed to delete user event of tenant: %w", err)
+		}
+	}
+
+	for _, obj := range keys {
+		if err = txn.Delete(ApiKeysTable, obj); err != nil {
+			return fmt.Errorf("failed to delete api key of tenant: %w", err)
+		}
+	}
+
+	if err = txn.Delete(TenantsTable, raw); err != nil {
+		return fmt.Errorf("failed to delete tenant: %w", err)
+	}
+
+	txn.Commit()
+
+	slog.DebugContext(ctx, "successfully deleted tenant from the memory database", slog.Int("users", len(users)))
+	return nil
+}
+
+// collect returns the objects of the table that match, deleting while iterating would invalidate the iterator.
+func collect(txn *memdb.Txn, table string, match func(obj interface{}) bool) ([]interface{}, error) {
+	it, err := txn.Get(table, "id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s: %w", table, err)
+	}
+
+	var matched []interface{}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		if match(obj) {
+			matched = append(matched, obj)
+		}
+	}
+	return matched, nil
+}
@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// Watcher - Structure for in-memory Watcher
+type Watcher struct {
+	database *db.Memory
+}
+
+func NewWatcher(database *db.Memory) *Watcher {
+	return &Watcher{
+		database: database,
+	}
+}
+
+// Head returns the id of the latest user event, 0 when no event was recorded yet.
+func (w *Watcher) Head(ctx context.Context) (id uint64, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "watcher.head")
+	defer span.End()
+
+	txn := w.database.DB.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.Last(UserEventsTable, "id")
+	if err != nil {
+		return 0, fmt.Errorf("failed to query user events: %w", err)
+	}
+	if raw == nil {
+		return 0, nil
+	}
+	return raw.(*storage.UserEvent).ID, nil
+}
+
+// Watch calls fn for the user events of the tenant after the given id, then waits for memdb to signal a change of the
+// events table and repeats. The watch channel is taken from the same snapshot the events are read from,
+// so an event committed in between is never missed.
+func (w *Watcher) Watch(ctx context.Context, after uint64, fn func(event storage.UserEvent) error) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "watcher.watch")
+	defer span.End()
+
+	slog.DebugContext(ctx, "watching user events", slog.Uint64("after", after))
+
+	if after != 0 {
+		if err = w.retained(after); err != nil {
+			return err
+		}
+	}
+
+	tenantID := tenancy.ID(ctx)
+
+	for {
+		txn := w.database.DB.Txn(false)
+
+		changed, _, err := txn.LastWatch(UserEventsTable, "id")
+		if err != nil {
+			txn.Abort()
+			return fmt.Errorf("failed to watch user events: %w", err)
+		}
+
+		it, err := txn.LowerBound(UserEventsTable, "id", after+1)
+		if err != nil {
+			txn.Abort()
+			return fmt.Errorf("failed to query user events: %w", err)
+		}
+
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			event := obj.(*storage.UserEvent)
+			after = event.ID
+			if event.User.GetTenantId() != tenantID {
+				continue
+			}
+			if err = fn(*event); err != nil {
+				txn.Abort()
+				return err
+			}
+		}
+		txn.Abort()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		}
+	}
+}
+
+// retained checks that the event a watcher resumes after was not cleaned up. Events are cleaned up in id order,
+// so the events after it are still there as well.
+func (w *Watcher) retained(id uint64) error {
+	txn := w.database.DB.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First(UserEventsTable, "id", id)
+	if err != nil {
+		return fmt.Errorf("failed to query user event: %w", err)
+	}
+	if raw == nil {
+		return apierrors.New(basev1.ErrorCode_ERROR_CODE_RESUME_TOKEN_EXPIRED)
+	}
+	return nil
+}
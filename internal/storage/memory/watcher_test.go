@@ -0,0 +1,184 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	MMDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/memory"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+var _ = Describe("Watcher", func() {
+	var db *MMDatabase.Memory
+	var dataWriter *DataWriter
+	var watcher *Watcher
+
+	BeforeEach(func() {
+		var err error
+		db, err = MMDatabase.New(Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(Seed(db)).Should(Succeed())
+
+		dataWriter = NewDataWriter(db)
+		watcher = NewWatcher(db)
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Head", func() {
+		It("follows the recorded events", func() {
+			ctx := context.Background()
+
+			head, err := watcher.Head(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(uint64(0)))
+
+			_, err = dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = dataWriter.Update(ctx, 1, "user-2", 0)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			head, err = watcher.Head(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(uint64(2)))
+		})
+	})
+
+	Context("Watch", func() {
+		It("streams recorded and new events in order", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			_, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var events []storage.UserEvent
+			done := make(chan error)
+			go func() {
+				done <- watcher.Watch(ctx, 0, func(event storage.UserEvent) error {
+					events = append(events, event)
+					if len(events) == 4 {
+						cancel()
+					}
+					return nil
+				})
+			}()
+
+			_, err = dataWriter.Update(ctx, 1, "user-2", 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			err = dataWriter.Delete(ctx, 1, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = dataWriter.Undelete(ctx, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Eventually(done).Should(Receive(BeNil()))
+
+			var types []storage.UserEventType
+			for i, event := range events {
+				Expect(event.ID).Should(Equal(uint64(i + 1)))
+				Expect(event.User.Id).Should(Equal(uint64(1)))
+				types = append(types, event.Type)
+			}
+			Expect(types).Should(Equal([]storage.UserEventType{
+				storage.UserCreated, storage.UserUpdated, storage.UserDeleted, storage.UserUndeleted,
+			}))
+			Expect(events[1].User.Name).Should(Equal("user-2"))
+			Expect(events[2].User.DeletedAt).ShouldNot(BeNil())
+		})
+
+		It("resumes after the given event", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			for _, name := range []string{"user-1", "user-2", "user-3"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			var ids []uint64
+			err := watcher.Watch(ctx, 1, func(event storage.UserEvent) error {
+				ids = append(ids, event.ID)
+				if len(ids) == 2 {
+					cancel()
+				}
+				return nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ids).Should(Equal([]uint64{2, 3}))
+		})
+
+		It("does not record failed writes", func() {
+			ctx := context.Background()
+
+			_, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).Should(HaveOccurred())
+			_, err = dataWriter.Update(ctx, 1, "user-2", 42)
+			Expect(err).Should(HaveOccurred())
+
+			head, err := watcher.Head(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(uint64(1)))
+		})
+
+		It("records an event per user of a batch", func() {
+			ctx := context.Background()
+
+			_, err := dataWriter.WriteBatch(ctx, []storage.User{
+				{ExternalID: "ext-1", Name: "user-1"},
+				{ExternalID: "ext-1", Name: "user-2"},
+				{ExternalID: "ext-3", Name: "user-3"},
+			}, true)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			head, err := watcher.Head(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(uint64(2)))
+		})
+
+		It("rejects resuming after a cleaned up event", func() {
+			ctx := context.Background()
+
+			for _, name := range []string{"user-1", "user-2"} {
+				_, err := dataWriter.Write(ctx, "ext-"+name, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			outbox := NewOutbox(db)
+			_, err := outbox.Relay(ctx, 10, func(storage.UserEvent) error {
+				return nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = outbox.Cleanup(ctx, time.Now().Add(time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = watcher.Watch(ctx, 1, func(storage.UserEvent) error {
+				return nil
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_RESUME_TOKEN_EXPIRED.String()))
+		})
+
+		It("stops when the callback fails", func() {
+			ctx := context.Background()
+
+			_, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			failure := errors.New("send failed")
+			err = watcher.Watch(ctx, 0, func(event storage.UserEvent) error {
+				return failure
+			})
+			Expect(err).Should(MatchError(failure))
+		})
+	})
+})
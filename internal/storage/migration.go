@@ -1,9 +1,13 @@
 package storage
 
 import (
+	"context"
 	"embed"
 	"fmt"
+	"io/fs"
 	"log"
+	"path/filepath"
+	"time"
 
 	"github.com/jackc/pgx/v5/stdlib"
 
@@ -233,35 +237,61 @@ func MigrateReset(engine, uri string) (err error) {
 	}
 }
 
-// MigrateStatus displays the status of all migrations.
-func MigrateStatus(engine, uri string) (err error) {
+// MigrationStatus describes a single migration and whether it has been applied to the database.
+type MigrationStatus struct {
+	Version   int64     `json:"version"`
+	Name      string    `json:"name"`
+	State     string    `json:"state"`
+	AppliedAt time.Time `json:"applied_at,omitempty"`
+}
+
+// MigrateStatus returns the status of all migrations.
+func MigrateStatus(engine, uri string) (statuses []MigrationStatus, err error) {
 	switch engine {
 	case database.POSTGRES.String():
 		var db *PQDatabase.Postgres
 		db, err = PQDatabase.New(uri)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		defer closeDB(db)
 
-		goose.SetTableName(migrationsTable)
-
-		if err = goose.SetDialect(postgresDialect); err != nil {
-			return err
+		var fsys fs.FS
+		fsys, err = fs.Sub(postgresMigrations, postgresMigrationDir)
+		if err != nil {
+			return nil, err
 		}
 
-		goose.SetBaseFS(postgresMigrations)
 		pool := stdlib.OpenDBFromPool(db.WritePool)
 
-		if err = goose.Status(pool, postgresMigrationDir); err != nil {
-			return err
+		var provider *goose.Provider
+		provider, err = goose.NewProvider(goose.DialectPostgres, pool, fsys, goose.WithTableName(migrationsTable))
+		if err != nil {
+			return nil, err
 		}
 
-		return nil
+		var results []*goose.MigrationStatus
+		results, err = provider.Status(context.Background())
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = make([]MigrationStatus, 0, len(results))
+		for _, result := range results {
+			statuses = append(statuses, MigrationStatus{
+				Version:   result.Source.Version,
+				Name:      filepath.Base(result.Source.Path),
+				State:     string(result.State),
+				AppliedAt: result.AppliedAt,
+			})
+		}
+
+		return statuses, nil
 	case database.MEMORY.String():
-		return nil
+		// No migrations exist for in-memory database
+		return []MigrationStatus{}, nil
 	default:
-		return fmt.Errorf("%s connection is unsupported", engine)
+		return nil, fmt.Errorf("%s connection is unsupported", engine)
 	}
 }
 
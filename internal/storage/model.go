@@ -1,24 +1,299 @@
 package storage
 
 import (
+	"strings"
 	"time"
 
-	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	"github.com/tolgaOzen/go-skeleton/pkg/etag"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
 )
 
 // User is the model for the user entity.
 type User struct {
+	ID         uint64
+	TenantID   uint64
+	ExternalID string
+	Name       string
+	CreatedAt  time.Time
+	DeletedAt  time.Time // zero while the user is not deleted
+	Revision   uint64    // incremented by every change
+}
+
+// ToProto - Convert database user to base user
+func (r User) ToProto() *basev1.User {
+	user := &basev1.User{
+		Id:         r.ID,
+		TenantId:   r.TenantID,
+		ExternalId: r.ExternalID,
+		Name:       r.Name,
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+		Revision:   r.Revision,
+		Etag:       etag.Format(r.Revision),
+	}
+	if r.Deleted() {
+		user.DeletedAt = timestamppb.New(r.DeletedAt)
+	}
+	return user
+}
+
+// Deleted - Whether the user is soft deleted
+func (r User) Deleted() bool {
+	return !r.DeletedAt.IsZero()
+}
+
+// OrderValue - Value of the ordered field as stored in continuous tokens, empty for id since the
+// token carries the id separately.
+func (r User) OrderValue(field string) string {
+	switch field {
+	case "name":
+		return r.Name
+	case "created_at":
+		return r.CreatedAt.UTC().Format(time.RFC3339Nano)
+	default:
+		return ""
+	}
+}
+
+// UserResult is the outcome of a single item of a batch write, either the user or the error.
+type UserResult struct {
+	User *basev1.User
+	Err  error
+}
+
+// UserOrderFields are the fields users can be ordered by.
+var UserOrderFields = []string{"id", "name", "created_at"}
+
+// DefaultUserOrder is the order users are listed in when none is given.
+var DefaultUserOrder = database.Order{Field: "created_at", Desc: true}
+
+// UserFilter narrows the users returned by a list, zero fields do not filter.
+type UserFilter struct {
+	NamePrefix    string    // case-insensitive prefix of the name
+	NameContains  string    // case-insensitive substring of the name
+	CreatedAfter  time.Time // inclusive lower bound of created_at
+	CreatedBefore time.Time // exclusive upper bound of created_at
+	ShowDeleted   bool      // include soft deleted users
+}
+
+// Matches - Whether the user passes the filter
+func (f UserFilter) Matches(u User) bool {
+	if u.Deleted() && !f.ShowDeleted {
+		return false
+	}
+	name := strings.ToLower(u.Name)
+	if f.NamePrefix != "" && !strings.HasPrefix(name, strings.ToLower(f.NamePrefix)) {
+		return false
+	}
+	if f.NameContains != "" && !strings.Contains(name, strings.ToLower(f.NameContains)) {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && u.CreatedAt.Before(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !u.CreatedAt.Before(f.CreatedBefore) {
+		return false
+	}
+	return true
+}
+
+// UserEventType is the kind of change recorded by a user event.
+type UserEventType string
+
+const (
+	UserCreated   UserEventType = "created"
+	UserUpdated   UserEventType = "updated"
+	UserDeleted   UserEventType = "deleted"
+	UserUndeleted UserEventType = "undeleted"
+)
+
+// ToProto - Convert the event type to the base event type
+func (t UserEventType) ToProto() basev1.UserEvent_Type {
+	switch t {
+	case UserCreated:
+		return basev1.UserEvent_TYPE_CREATED
+	case UserUpdated:
+		return basev1.UserEvent_TYPE_UPDATED
+	case UserDeleted:
+		return basev1.UserEvent_TYPE_DELETED
+	case UserUndeleted:
+		return basev1.UserEvent_TYPE_UNDELETED
+	default:
+		return basev1.UserEvent_TYPE_UNSPECIFIED
+	}
+}
+
+// UserEvent is the model for a change made to a user, written in the same transaction as the change.
+type UserEvent struct {
+	ID          uint64 // identifies the event, events are ordered by the transaction that recorded them rather than by id
+	Type        UserEventType
+	User        *basev1.User // the user right after the change
+	CreatedAt   time.Time
+	PublishedAt time.Time // zero until the event is relayed
+}
+
+// Published - Whether the event was relayed
+func (e UserEvent) Published() bool {
+	return !e.PublishedAt.IsZero()
+}
+
+// ToProto - Convert database user event to base user event, the resume token is left to the caller since
+// it is signed before it is handed out
+func (e UserEvent) ToProto() *basev1.UserEvent {
+	return &basev1.UserEvent{
+		Type:       e.Type.ToProto(),
+		User:       e.User,
+		OccurredAt: timestamppb.New(e.CreatedAt),
+	}
+}
+
+// eventOrder is the order events are watched in, resume tokens are continuous tokens issued for it.
+var eventOrder = database.Order{Field: "id"}
+
+// EventToken - Resume token of the event with the given id
+func EventToken(id uint64) database.EncodedContinuousToken {
+	return database.NewContinuousToken(eventOrder, "", id).Encode()
+}
+
+// ParseEventToken - Id of the event a resume token was issued for
+func ParseEventToken(token string) (uint64, error) {
+	ct, err := database.NewEncodedContinuousToken(token).Decode(eventOrder)
+	if err != nil {
+		return 0, err
+	}
+	return ct.ID, nil
+}
+
+// Tenant is the model for a customer whose users are kept apart from the users of every other tenant.
+type Tenant struct {
 	ID        uint64
 	Name      string
 	CreatedAt time.Time
 }
 
-// ToProto - Convert database user to base user
-func (r User) ToProto() *basev1.User {
-	return &basev1.User{
-		Id:        r.ID,
-		Name:      r.Name,
-		CreatedAt: timestamppb.New(r.CreatedAt),
+// ToProto - Convert database tenant to base tenant
+func (t Tenant) ToProto() *basev1.Tenant {
+	return &basev1.Tenant{
+		Id:        t.ID,
+		Name:      t.Name,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+}
+
+// TenantOrder is the order tenants are listed in.
+var TenantOrder = database.Order{Field: "id"}
+
+// ApiKey is the model for a key minted by the ApiKeyService, only the digest of its secret is stored.
+type ApiKey struct {
+	ID        uint64
+	TenantID  uint64
+	Name      string
+	Prefix    string // first characters of the secret
+	Hash      string // hex encoded SHA-256 digest of the secret
+	Roles     []string
+	CreatedAt time.Time
+	ExpiresAt time.Time // zero when the key never expires
+	RevokedAt time.Time // zero while the key is not revoked
+}
+
+// Revoked - Whether the key has been revoked
+func (k ApiKey) Revoked() bool {
+	return !k.RevokedAt.IsZero()
+}
+
+// Valid - Whether callers can authenticate with the key at the given time
+func (k ApiKey) Valid(at time.Time) bool {
+	return !k.Revoked() && (k.ExpiresAt.IsZero() || at.Before(k.ExpiresAt))
+}
+
+// ToProto - Convert database api key to base api key, the hash is left out
+func (k ApiKey) ToProto() *basev1.ApiKey {
+	key := &basev1.ApiKey{
+		Id:        k.ID,
+		TenantId:  k.TenantID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Roles:     k.Roles,
+		CreatedAt: timestamppb.New(k.CreatedAt),
+	}
+	if !k.ExpiresAt.IsZero() {
+		key.ExpiresAt = timestamppb.New(k.ExpiresAt)
+	}
+	if k.Revoked() {
+		key.RevokedAt = timestamppb.New(k.RevokedAt)
+	}
+	return key
+}
+
+// ApiKeyOrder is the order API keys are listed in.
+var ApiKeyOrder = database.Order{Field: "id"}
+
+// IdempotencyRecord is the model for an idempotency key.
+type IdempotencyRecord struct {
+	Key         string
+	Method      string
+	RequestHash []byte
+	Response    []byte // empty for responses that marshal to no bytes
+	CreatedAt   time.Time
+	CompletedAt time.Time // zero while the request is in progress
+	ExpiresAt   time.Time
+}
+
+// Pending - Whether the request the key was reserved for has not completed yet
+func (r IdempotencyRecord) Pending() bool {
+	return r.CompletedAt.IsZero()
+}
+
+// AuditEvent is the model for a record of a call that changed data.
+type AuditEvent struct {
+	ID            uint64
+	TenantID      uint64 // tenant the call was made in
+	Actor         string // subject of the principal, empty when authentication is disabled
+	Method        string // full name of the called RPC
+	ResourceID    string // empty when the call targeted no single resource
+	RequestDigest string // hex encoded SHA-256 digest of the request payload
+	Code          string // gRPC status code the call completed with
+	Replayed      bool   // whether the response was replayed from an earlier call with the same idempotency key
+	CreatedAt     time.Time
+}
+
+// ToProto - Convert database audit event to base audit event
+func (e AuditEvent) ToProto() *basev1.AuditEvent {
+	return &basev1.AuditEvent{
+		Id:            e.ID,
+		TenantId:      e.TenantID,
+		Actor:         e.Actor,
+		Method:        e.Method,
+		ResourceId:    e.ResourceID,
+		RequestDigest: e.RequestDigest,
+		Code:          e.Code,
+		Replayed:      e.Replayed,
+		OccurredAt:    timestamppb.New(e.CreatedAt),
+	}
+}
+
+// AuditOrder is the order audit events are listed in, latest first.
+var AuditOrder = database.Order{Field: "id", Desc: true}
+
+// AuditFilter narrows the audit events returned by a list, zero fields do not filter.
+type AuditFilter struct {
+	Actor  string
+	After  time.Time // inclusive lower bound of created_at
+	Before time.Time // exclusive upper bound of created_at
+}
+
+// Matches - Whether the audit event passes the filter
+func (f AuditFilter) Matches(e AuditEvent) bool {
+	if f.Actor != "" && e.Actor != f.Actor {
+		return false
+	}
+	if !f.After.IsZero() && e.CreatedAt.Before(f.After) {
+		return false
+	}
+	if !f.Before.IsZero() && !e.CreatedAt.Before(f.Before) {
+		return false
 	}
+	return true
 }
@@ -0,0 +1,296 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// ApiKeyStore - Structure for Api Key Store
+type ApiKeyStore struct {
+	database *db.Postgres
+}
+
+func NewApiKeyStore(database *db.Postgres) *ApiKeyStore {
+	return &ApiKeyStore{
+		database: database,
+	}
+}
+
+// Create stores a key in the tenant, the id and creation time are assigned by the database.
+func (s *ApiKeyStore) Create(ctx context.Context, key storage.ApiKey) (created *basev1.ApiKey, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "api-key-store.create")
+	defer span.End()
+
+	slog.DebugContext(ctx, "create api key", slog.String("name", key.Name))
+
+	fnd, err := s.insert(ctx, s.database.WritePool, tenancy.ID(ctx), key)
+	if err != nil {
+		return nil, err
+	}
+	return fnd.ToProto(), nil
+}
+
+// Get returns the key of the tenant with the id.
+func (s *ApiKeyStore) Get(ctx context.Context, id uint64) (key storage.ApiKey, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "api-key-store.get")
+	defer span.End()
+
+	query, args, err := s.database.Builder.
+		Select(ApiKeyColumns).
+		From(ApiKeysTable).
+		Where(squirrel.Eq{"id": id, "tenant_id": tenancy.ID(ctx)}).
+		ToSql()
+	if err != nil {
+		return storage.ApiKey{}, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	key, err = scanApiKey(s.database.ReadPool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ApiKey{}, apiKeyNotFound(id)
+		}
+		return storage.ApiKey{}, fmt.Errorf("failed to query api key: %w", err)
+	}
+	return key, nil
+}
+
+// List returns the keys of the tenant in id order, starting after the id of the token.
+func (s *ApiKeyStore) List(ctx context.Context, pagination database.Pagination) (keys []*basev1.ApiKey, ct database.EncodedContinuousToken, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "api-key-store.list")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying api keys")
+
+	where := squirrel.And{squirrel.Eq{"tenant_id": tenancy.ID(ctx)}}
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = database.NewEncodedContinuousToken(pagination.Token()).Decode(storage.ApiKeyOrder)
+		if err != nil {
+			return nil, database.NewEncodedContinuousToken(""), apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+		}
+		where = append(where, squirrel.Gt{"id": t.ID})
+	}
+
+	query, args, err := s.database.Builder.
+		Select(ApiKeyColumns).
+		From(ApiKeysTable).
+		Where(where).
+		OrderBy("id").
+		Limit(uint64(pagination.Size()) + 1).
+		ToSql()
+	if err != nil {
+		return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	var rows pgx.Rows
+	rows, err = s.database.ReadPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, database.NewEncodedContinuousToken(""), err
+	}
+	defer rows.Close()
+
+	var fetched []storage.ApiKey
+	for rows.Next() {
+		var fnd storage.ApiKey
+		fnd, err = scanApiKey(rows)
+		if err != nil {
+			return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("failed to scan row: %w", err)
+		}
+		fetched = append(fetched, fnd)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("row iteration error: %w", err)
+	}
+
+	ct = database.NewEncodedContinuousToken("")
+	if uint32(len(fetched)) > pagination.Size() {
+		fetched = fetched[:pagination.Size()]
+		if len(fetched) > 0 {
+			ct = database.NewContinuousToken(storage.ApiKeyOrder, "", fetched[len(fetched)-1].ID).Encode()
+		}
+	}
+
+	for _, fnd := range fetched {
+		keys = append(keys, fnd.ToProto())
+	}
+
+	return keys, ct, nil
+}
+
+// Revoke sets the revocation time of the key of the tenant unless it is already revoked.
+func (s *ApiKeyStore) Revoke(ctx context.Context, id uint64) (revoked *basev1.ApiKey, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "api-key-store.revoke")
+	defer span.End()
+
+	slog.DebugContext(ctx, "revoke api key", slog.Uint64("id", id))
+
+	query, args, err := s.database.Builder.
+		Update(ApiKeysTable).
+		Set("revoked_at", squirrel.Expr("COALESCE(revoked_at, now())")).
+		Where(squirrel.Eq{"id": id, "tenant_id": tenancy.ID(ctx)}).
+		Suffix("RETURNING " + ApiKeyColumns).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	fnd, err := scanApiKey(s.database.WritePool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apiKeyNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	return fnd.ToProto(), nil
+}
+
+// Rotate revokes the key of the tenant and stores the replacement in the same transaction, the key is locked
+// so concurrent rotations of it can not both succeed.
+func (s *ApiKeyStore) Rotate(ctx context.Context, id uint64, replacement storage.ApiKey) (created *basev1.ApiKey, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "api-key-store.rotate")
+	defer span.End()
+
+	slog.DebugContext(ctx, "rotate api key", slog.Uint64("id", id))
+
+	tx, err := s.database.WritePool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+		} else if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	query, args, err := s.database.Builder.
+		Select(ApiKeyColumns).
+		From(ApiKeysTable).
+		Where(squirrel.Eq{"id": id, "tenant_id": tenancy.ID(ctx)}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	fnd, err := scanApiKey(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apiKeyNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to query api key: %w", err)
+	}
+	if fnd.Revoked() {
+		return nil, apierrors.Newf(basev1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, "api key %d is revoked", id)
+	}
+
+	query, args, err = s.database.Builder.
+		Update(ApiKeysTable).
+		Set("revoked_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	replacement.Name = fnd.Name
+	replacement.Roles = fnd.Roles
+	if replacement.ExpiresAt.IsZero() {
+		replacement.ExpiresAt = fnd.ExpiresAt
+	}
+	stored, err := s.insert(ctx, tx, fnd.TenantID, replacement)
+	if err != nil {
+		return nil, err
+	}
+	return stored.ToProto(), nil
+}
+
+// Lookup finds the key of any tenant by the digest of its secret, the row-level security policies are
+// bypassed since the tenant of the caller is only known once its key has been found.
+func (s *ApiKeyStore) Lookup(ctx context.Context, hash string) (key storage.ApiKey, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(tenancy.Unscoped(ctx), "api-key-store.lookup")
+	defer span.End()
+
+	query, args, err := s.database.Builder.
+		Select(ApiKeyColumns).
+		From(ApiKeysTable).
+		Where(squirrel.Eq{"hash": hash}).
+		ToSql()
+	if err != nil {
+		return storage.ApiKey{}, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	key, err = scanApiKey(s.database.ReadPool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ApiKey{}, apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND)
+		}
+		return storage.ApiKey{}, fmt.Errorf("failed to query api key: %w", err)
+	}
+	return key, nil
+}
+
+// querier is the part of a pool or a transaction keys are inserted with.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// insert stores the key in the tenant.
+func (s *ApiKeyStore) insert(ctx context.Context, q querier, tenantID uint64, key storage.ApiKey) (storage.ApiKey, error) {
+	var expiresAt *time.Time
+	if !key.ExpiresAt.IsZero() {
+		expiresAt = &key.ExpiresAt
+	}
+	roles := key.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+
+	query, args, err := s.database.Builder.
+		Insert(ApiKeysTable).
+		Columns("tenant_id", "name", "prefix", "hash", "roles", "expires_at").
+		Values(tenantID, key.Name, key.Prefix, key.Hash, roles, expiresAt).
+		Suffix("RETURNING " + ApiKeyColumns).
+		ToSql()
+	if err != nil {
+		return storage.ApiKey{}, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	fnd, err := scanApiKey(q.QueryRow(ctx, query, args...))
+	if err != nil {
+		return storage.ApiKey{}, fmt.Errorf("failed to insert api key: %w", err)
+	}
+	return fnd, nil
+}
+
+// apiKeyNotFound is the error for a key the tenant does not have.
+func apiKeyNotFound(id uint64) error {
+	return apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND).WithMetadata("api_key_id", strconv.FormatUint(id, 10))
+}
@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/storage/postgres/instance"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	PQDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+var _ = Describe("ApiKeyStore", func() {
+	var db database.Database
+	var store *ApiKeyStore
+	var tenants *TenantStore
+
+	BeforeEach(func() {
+		version := os.Getenv("POSTGRES_VERSION")
+
+		if version == "" {
+			version = "14"
+		}
+
+		db = instance.PostgresDB(version, tenantSession)
+		store = NewApiKeyStore(db.(*PQDatabase.Postgres))
+		tenants = NewTenantStore(db.(*PQDatabase.Postgres))
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	page := func(size uint32, token string) database.Pagination {
+		return database.NewPagination(database.Size(size), database.Token(token), database.OrderBy(storage.ApiKeyOrder))
+	}
+
+	hash := func(secret string) string {
+		digest := sha256.Sum256([]byte(secret))
+		return hex.EncodeToString(digest[:])
+	}
+
+	key := func(name string) storage.ApiKey {
+		return storage.ApiKey{Name: name, Prefix: "sk_" + name, Hash: hash(name), Roles: []string{"viewer"}}
+	}
+
+	Context("Create", func() {
+		It("creates keys in the tenant and finds them by hash", func() {
+			created, err := store.Create(inDefaultTenant, key("ci"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(created.GetTenantId()).Should(Equal(tenancy.DefaultTenant))
+			Expect(created.GetRoles()).Should(Equal([]string{"viewer"}))
+			Expect(created.GetExpiresAt()).Should(BeNil())
+			Expect(created.GetRevokedAt()).Should(BeNil())
+
+			fnd, err := store.Lookup(inDefaultTenant, hash("ci"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fnd.ID).Should(Equal(created.GetId()))
+			Expect(fnd.Hash).Should(Equal(hash("ci")))
+
+			_, err = store.Lookup(inDefaultTenant, hash("cd"))
+			Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND))
+		})
+
+		It("finds the keys of every tenant by hash", func() {
+			tenant, err := tenants.Create(inDefaultTenant, "acme")
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = store.Create(tenancy.NewContext(context.Background(), tenant.GetId()), key("ci"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fnd, err := store.Lookup(inDefaultTenant, hash("ci"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fnd.TenantID).Should(Equal(tenant.GetId()))
+		})
+	})
+
+	Context("List", func() {
+		It("lists the keys of the tenant across pages", func() {
+			tenant, err := tenants.Create(inDefaultTenant, "acme")
+			Expect(err).ShouldNot(HaveOccurred())
+			other := tenancy.NewContext(context.Background(), tenant.GetId())
+
+			for _, name := range []string{"a", "b", "c"} {
+				_, err = store.Create(inDefaultTenant, key(name))
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			_, err = store.Create(other, key("d"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			keys, ct, err := store.List(inDefaultTenant, page(2, ""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(keys).Should(HaveLen(2))
+			Expect(keys[0].GetName()).Should(Equal("a"))
+			Expect(ct.String()).ShouldNot(BeEmpty())
+
+			keys, ct, err = store.List(inDefaultTenant, page(2, ct.String()))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(keys).Should(HaveLen(1))
+			Expect(keys[0].GetName()).Should(Equal("c"))
+			Expect(ct.String()).Should(BeEmpty())
+
+			keys, _, err = store.List(other, page(10, ""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(keys).Should(HaveLen(1))
+			Expect(keys[0].GetName()).Should(Equal("d"))
+		})
+	})
+
+	Context("Revoke", func() {
+		It("revokes a key once", func() {
+			created, err := store.Create(inDefaultTenant, key("ci"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			revoked, err := store.Revoke(inDefaultTenant, created.GetId())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(revoked.GetRevokedAt()).ShouldNot(BeNil())
+
+			again, err := store.Revoke(inDefaultTenant, created.GetId())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again.GetRevokedAt().AsTime()).Should(Equal(revoked.GetRevokedAt().AsTime()))
+
+			fnd, err := store.Lookup(inDefaultTenant, hash("ci"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fnd.Valid(time.Now())).Should(BeFalse())
+		})
+
+		It("does not revoke the keys of other tenants", func() {
+			created, err := store.Create(inDefaultTenant, key("ci"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = store.Revoke(tenancy.NewContext(context.Background(), 2), created.GetId())
+			Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND))
+		})
+	})
+
+	Context("Rotate", func() {
+		It("revokes the key and stores its replacement", func() {
+			expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+			original := key("ci")
+			original.ExpiresAt = expiresAt
+			created, err := store.Create(inDefaultTenant, original)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rotated, err := store.Rotate(inDefaultTenant, created.GetId(), storage.ApiKey{Prefix: "sk_new", Hash: hash("new")})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rotated.GetId()).ShouldNot(Equal(created.GetId()))
+			Expect(rotated.GetName()).Should(Equal("ci"))
+			Expect(rotated.GetRoles()).Should(Equal([]string{"viewer"}))
+			Expect(rotated.GetExpiresAt().AsTime()).Should(Equal(expiresAt))
+
+			old, err := store.Get(inDefaultTenant, created.GetId())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(old.Revoked()).Should(BeTrue())
+
+			_, err = store.Rotate(inDefaultTenant, created.GetId(), storage.ApiKey{Prefix: "sk_new", Hash: hash("newer")})
+			Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT))
+		})
+	})
+
+	It("deletes the keys of a deleted tenant", func() {
+		tenant, err := tenants.Create(inDefaultTenant, "acme")
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = store.Create(tenancy.NewContext(context.Background(), tenant.GetId()), key("ci"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(tenants.Delete(inDefaultTenant, tenant.GetId())).Should(Succeed())
+
+		_, err = store.Lookup(inDefaultTenant, hash("ci"))
+		Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND))
+	})
+})
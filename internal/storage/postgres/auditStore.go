@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// AuditStore - Structure for Audit Store
+type AuditStore struct {
+	database *db.Postgres
+}
+
+func NewAuditStore(database *db.Postgres) *AuditStore {
+	return &AuditStore{
+		database: database,
+	}
+}
+
+// Write appends an audit event to the tenant, the id and creation time are assigned by the database.
+func (s *AuditStore) Write(ctx context.Context, event storage.AuditEvent) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "audit-store.write")
+	defer span.End()
+
+	slog.DebugContext(ctx, "write audit event", slog.String("method", event.Method))
+
+	query, args, err := s.database.Builder.
+		Insert(AuditEventsTable).
+		Columns("tenant_id", "actor", "method", "resource_id", "request_digest", "code", "replayed").
+		Values(tenancy.ID(ctx), event.Actor, event.Method, event.ResourceID, event.RequestDigest, event.Code, event.Replayed).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	if _, err = s.database.WritePool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to write audit event: %w", err)
+	}
+	return nil
+}
+
+// List returns the audit events of the tenant matching the filter, latest first, starting below the id of the token.
+func (s *AuditStore) List(ctx context.Context, filter storage.AuditFilter, pagination database.Pagination) (events []storage.AuditEvent, ct database.EncodedContinuousToken, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "audit-store.list")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying audit events")
+
+	where := squirrel.And{squirrel.Eq{"tenant_id": tenancy.ID(ctx)}}
+	if filter.Actor != "" {
+		where = append(where, squirrel.Eq{"actor": filter.Actor})
+	}
+	if !filter.After.IsZero() {
+		where = append(where, squirrel.GtOrEq{"created_at": filter.After})
+	}
+	if !filter.Before.IsZero() {
+		where = append(where, squirrel.Lt{"created_at": filter.Before})
+	}
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = database.NewEncodedContinuousToken(pagination.Token()).Decode(storage.AuditOrder)
+		if err != nil {
+			return nil, database.NewEncodedContinuousToken(""), apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+		}
+		where = append(where, squirrel.Lt{"id": t.ID})
+	}
+
+	// Fetch one extra row to find out whether there is a next page.
+	query, args, err := s.database.Builder.
+		Select(AuditEventColumns).
+		From(AuditEventsTable).
+		Where(where).
+		OrderBy("id DESC").
+		Limit(uint64(pagination.Size()) + 1).
+		ToSql()
+	if err != nil {
+		return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	slog.DebugContext(ctx, "generated sql query", slog.String("query", query), slog.Any("arguments", args))
+
+	rows, err := s.database.ReadPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, database.NewEncodedContinuousToken(""), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var fnd storage.AuditEvent
+		fnd, err = scanAuditEvent(rows)
+		if err != nil {
+			return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("failed to scan row: %w", err)
+		}
+		events = append(events, fnd)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("row iteration error: %w", err)
+	}
+
+	ct = database.NewEncodedContinuousToken("")
+	if uint32(len(events)) > pagination.Size() {
+		events = events[:pagination.Size()]
+		if len(events) > 0 {
+			ct = database.NewContinuousToken(storage.AuditOrder, "", events[len(events)-1].ID).Encode()
+		}
+	}
+
+	return events, ct, nil
+}
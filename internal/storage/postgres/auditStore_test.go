@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/storage/postgres/instance"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	PQDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+)
+
+var _ = Describe("AuditStore", func() {
+	var db database.Database
+	var store *AuditStore
+
+	BeforeEach(func() {
+		version := os.Getenv("POSTGRES_VERSION")
+
+		if version == "" {
+			version = "14"
+		}
+
+		db = instance.PostgresDB(version, tenantSession)
+		store = NewAuditStore(db.(*PQDatabase.Postgres))
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	event := func(actor, resourceID string) storage.AuditEvent {
+		return storage.AuditEvent{
+			Actor:         actor,
+			Method:        "/base.v1.UserService/Update",
+			ResourceID:    resourceID,
+			RequestDigest: "digest",
+			Code:          "OK",
+		}
+	}
+
+	page := func(size uint32, token string) database.Pagination {
+		return database.NewPagination(database.Size(size), database.Token(token), database.OrderBy(storage.AuditOrder))
+	}
+
+	Context("List", func() {
+		It("lists the events latest first across pages", func() {
+			ctx := inDefaultTenant
+
+			for _, id := range []string{"1", "2", "3"} {
+				err := store.Write(ctx, event("alice", id))
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			events, ct, err := store.List(ctx, storage.AuditFilter{}, page(2, ""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(events).Should(HaveLen(2))
+			Expect(events[0].ResourceID).Should(Equal("3"))
+			Expect(events[1].ResourceID).Should(Equal("2"))
+			Expect(events[0].CreatedAt.IsZero()).Should(BeFalse())
+			Expect(ct.String()).ShouldNot(BeEmpty())
+
+			events, ct, err = store.List(ctx, storage.AuditFilter{}, page(2, ct.String()))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(events).Should(HaveLen(1))
+			Expect(events[0].ResourceID).Should(Equal("1"))
+			Expect(ct.String()).Should(BeEmpty())
+		})
+
+		It("keeps whether the call was replayed", func() {
+			ctx := inDefaultTenant
+
+			replayed := event("alice", "1")
+			replayed.Replayed = true
+			err := store.Write(ctx, replayed)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			events, _, err := store.List(ctx, storage.AuditFilter{}, page(10, ""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(events).Should(HaveLen(1))
+			Expect(events[0].Replayed).Should(BeTrue())
+		})
+
+		It("filters by actor and time range", func() {
+			ctx := inDefaultTenant
+
+			err := store.Write(ctx, event("alice", "1"))
+			Expect(err).ShouldNot(HaveOccurred())
+			err = store.Write(ctx, event("bob", "2"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			events, _, err := store.List(ctx, storage.AuditFilter{Actor: "bob"}, page(10, ""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(events).Should(HaveLen(1))
+			Expect(events[0].Actor).Should(Equal("bob"))
+			Expect(events[0].Method).Should(Equal("/base.v1.UserService/Update"))
+			Expect(events[0].RequestDigest).Should(Equal("digest"))
+			Expect(events[0].Code).Should(Equal("OK"))
+			Expect(events[0].Replayed).Should(BeFalse())
+
+			events, _, err = store.List(ctx, storage.AuditFilter{After: time.Now().Add(-time.Hour)}, page(10, ""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(events).Should(HaveLen(2))
+
+			events, _, err = store.List(ctx, storage.AuditFilter{After: time.Now().Add(time.Hour)}, page(10, ""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(events).Should(BeEmpty())
+
+			events, _, err = store.List(ctx, storage.AuditFilter{Before: time.Now().Add(-time.Hour)}, page(10, ""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(events).Should(BeEmpty())
+		})
+
+		It("rejects a malformed page token", func() {
+			_, _, err := store.List(inDefaultTenant, storage.AuditFilter{}, page(10, "bogus"))
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -1,5 +1,68 @@
 package postgres
 
+import "time"
+
 const (
-	UsersTable = "users"
+	UsersTable           = "users"
+	TenantsTable         = "tenants"
+	IdempotencyKeysTable = "idempotency_keys"
+	UserEventsTable      = "user_events"
+	AuditEventsTable     = "audit_events"
+	ApiKeysTable         = "api_keys"
+
+	// UserEventsChannel is the channel watchers are notified on when user events are committed.
+	UserEventsChannel = "user_events"
+
+	// TenantSetting is the session variable the row-level security policies compare the tenant_id of rows
+	// against, connections without it see no rows of the tenant owned tables.
+	TenantSetting = "app.tenant_id"
+
+	// allTenants is the value of TenantSetting letting unscoped maintenance see the rows of every tenant.
+	allTenants = "*"
+
+	// UserColumns are the columns scanned by scanUser, in order.
+	UserColumns = "id, tenant_id, COALESCE(external_id, ''), name, created_at, deleted_at, revision"
+
+	// TenantColumns are the columns scanned by scanTenant, in order.
+	TenantColumns = "id, name, created_at"
+
+	// UserEventColumns are the columns scanned by scanUserEvent, in order.
+	UserEventColumns = "id, type, payload, created_at, published_at"
+
+	// AuditEventColumns are the columns scanned by scanAuditEvent, in order.
+	AuditEventColumns = "id, tenant_id, actor, method, resource_id, request_digest, code, replayed, created_at"
+
+	// ApiKeyColumns are the columns scanned by scanApiKey, in order.
+	ApiKeyColumns = "id, tenant_id, name, prefix, hash, roles, created_at, expires_at, revoked_at"
+
+	// exportBatchSize is the number of rows fetched from the export cursor at once.
+	exportBatchSize = 500
+
+	// eventHorizon is the position below which every transaction has ended: the oldest transaction id still
+	// running. User events below it are all committed or rolled back, no event can appear before them anymore.
+	eventHorizon = "pg_snapshot_xmin(pg_current_snapshot())::TEXT::BIGINT"
+
+	// relayLock is the advisory lock held by the one relay publishing user events at a time.
+	relayLock = "user_events_relay"
+
+	// watchBatchSize is the maximum number of user events read at once while watching.
+	watchBatchSize = 500
+
+	// watchPollInterval is how often watchers look for new user events on their own, in case a
+	// notification was lost while the listening connection was being reestablished.
+	watchPollInterval = 5 * time.Second
+
+	// uniqueViolation is the SQLSTATE postgres reports when a unique index rejects a row.
+	uniqueViolation = "23505"
+
+	// foreignKeyViolation is the SQLSTATE postgres reports when a row references a missing row.
+	foreignKeyViolation = "23503"
 )
+
+// userOrderColumns maps the fields users can be ordered by to their columns. Order fields are
+// only ever taken from this map so user input never reaches the ORDER BY clause.
+var userOrderColumns = map[string]string{
+	"id":         "id",
+	"name":       "name",
+	"created_at": "created_at",
+}
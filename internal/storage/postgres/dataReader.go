@@ -2,16 +2,22 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
 
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
 	"github.com/tolgaOzen/go-skeleton/pkg/database"
 	db "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
 
 	"github.com/tolgaOzen/go-skeleton/internal"
 	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
 	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
 )
 
@@ -31,27 +37,72 @@ func NewDataReader(database *db.Postgres) *DataReader {
 	}
 }
 
-// ReadUsers reads relation tuples from the storage based on the given filter.
-func (r *DataReader) ReadUsers(ctx context.Context, pagination database.Pagination) (users []*basev1.User, err error) {
+// ReadUsers reads the users matching the filter from the storage, in the order of the pagination or newest first.
+// When the pagination carries a continuous token the page is located with a keyset condition on the ordered
+// column and id, otherwise it falls back to offset based page numbers.
+func (r *DataReader) ReadUsers(ctx context.Context, filter storage.UserFilter, pagination database.Pagination) (users []*basev1.User, ct database.EncodedContinuousToken, err error) {
 	// Start a new trace span and end it when the function exits.
 	ctx, span := internal.Tracer.Start(ctx, "data-reader.read-users")
 	defer span.End()
 
 	slog.DebugContext(ctx, "querying users")
 
+	order := pagination.Order()
+	if order.Field == "" {
+		order = storage.DefaultUserOrder
+	}
+	column, ok := userOrderColumns[order.Field]
+	if !ok {
+		return nil, database.NewEncodedContinuousToken(""), apierrors.Newf(basev1.ErrorCode_ERROR_CODE_VALIDATION, "users can not be ordered by %s", order.Field)
+	}
+	direction, cmp := "ASC", ">"
+	if order.Desc {
+		direction, cmp = "DESC", "<"
+	}
+
 	var args []interface{}
 	builder := r.database.Builder.
-		Select("id, name, created_at").
+		Select(UserColumns).
 		From(UsersTable).
-		OrderBy("created_at DESC").
-		Limit(uint64(pagination.Size())).
-		Offset(uint64(pagination.Size()) * uint64(max(0, pagination.Page()-1)))
+		Where(userFilter(tenancy.ID(ctx), filter))
+
+	if column == "id" {
+		builder = builder.OrderBy("id " + direction)
+	} else {
+		builder = builder.OrderBy(column+" "+direction, "id "+direction)
+	}
+
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = database.NewEncodedContinuousToken(pagination.Token()).Decode(order)
+		if err != nil {
+			return nil, database.NewEncodedContinuousToken(""), apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+		}
+		switch column {
+		case "id":
+			builder = builder.Where(squirrel.Expr("id "+cmp+" ?", t.ID))
+		case "created_at":
+			var createdAt time.Time
+			createdAt, err = time.Parse(time.RFC3339Nano, t.Value)
+			if err != nil {
+				return nil, database.NewEncodedContinuousToken(""), apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+			}
+			builder = builder.Where(squirrel.Expr("(created_at, id) "+cmp+" (?, ?)", createdAt, t.ID))
+		default:
+			builder = builder.Where(squirrel.Expr("("+column+", id) "+cmp+" (?, ?)", t.Value, t.ID))
+		}
+	} else {
+		builder = builder.Offset(uint64(pagination.Size()) * uint64(max(1, pagination.Page())-1))
+	}
+
+	// Fetch one extra row to find out whether there is a next page.
+	builder = builder.Limit(uint64(pagination.Size()) + 1)
 
 	// Generate the SQL query and arguments.
 	var query string
 	query, args, err = builder.ToSql()
 	if err != nil {
-		return nil, err
+		return nil, database.NewEncodedContinuousToken(""), err
 	}
 
 	slog.DebugContext(ctx, "generated sql query", slog.String("query", query), slog.Any("arguments", args))
@@ -59,18 +110,135 @@ func (r *DataReader) ReadUsers(ctx context.Context, pagination database.Paginati
 	// Execute the SQL query and retrieve the result rows.
 	var rows pgx.Rows
 	rows, err = r.database.ReadPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, database.NewEncodedContinuousToken(""), err
+	}
+	defer rows.Close()
+
+	var fetched []storage.User
+	for rows.Next() {
+		var fnd storage.User
+		fnd, err = scanUser(rows)
+		if err != nil {
+			return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("failed to scan row: %w", err)
+		}
+		fetched = append(fetched, fnd)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("row iteration error: %w", err)
+	}
+
+	ct = database.NewEncodedContinuousToken("")
+	if uint32(len(fetched)) > pagination.Size() {
+		fetched = fetched[:pagination.Size()]
+		if len(fetched) > 0 {
+			last := fetched[len(fetched)-1]
+			ct = database.NewContinuousToken(order, last.OrderValue(order.Field), last.ID).Encode()
+		}
+	}
+
+	for _, fnd := range fetched {
+		users = append(users, fnd.ToProto())
+	}
+
+	slog.DebugContext(ctx, "successfully retrieved and converted users from the database")
+
+	// Return the results.
+	return users, ct, nil
+}
+
+// CountUsers counts the users matching the filter.
+func (r *DataReader) CountUsers(ctx context.Context, filter storage.UserFilter) (count int64, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.count-users")
+	defer span.End()
+
+	slog.DebugContext(ctx, "counting users")
+
+	builder := r.database.Builder.
+		Select("COUNT(*)").
+		From(UsersTable).
+		Where(userFilter(tenancy.ID(ctx), filter))
+
+	// Generate the SQL query and arguments.
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	slog.DebugContext(ctx, "generated sql query", slog.String("query", query), slog.Any("arguments", args))
+
+	if err = r.database.ReadPool.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to scan row: %w", err)
+	}
+
+	return count, nil
+}
+
+// ReadUser reads a single user from the storage by its id, soft deleted users are not found.
+func (r *DataReader) ReadUser(ctx context.Context, id uint64) (user *basev1.User, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.read-user")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying user", slog.Uint64("id", id))
+
+	builder := r.database.Builder.
+		Select(UserColumns).
+		From(UsersTable).
+		Where(squirrel.Eq{"id": id, "tenant_id": tenancy.ID(ctx), "deleted_at": nil})
+
+	// Generate the SQL query and arguments.
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "generated sql query", slog.String("query", query), slog.Any("arguments", args))
+
+	fnd, err := scanUser(r.database.ReadPool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND)
+		}
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
+
+	slog.DebugContext(ctx, "successfully retrieved user from the database")
+
+	return fnd.ToProto(), nil
+}
+
+// ReadUsersByIDs reads the users with the given ids from the storage, soft deleted users are left out.
+func (r *DataReader) ReadUsersByIDs(ctx context.Context, ids []uint64) (users []*basev1.User, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.read-users-by-ids")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying users by ids", slog.Int("count", len(ids)))
+
+	builder := r.database.Builder.
+		Select(UserColumns).
+		From(UsersTable).
+		Where(squirrel.Eq{"id": ids, "tenant_id": tenancy.ID(ctx), "deleted_at": nil})
+
+	// Generate the SQL query and arguments.
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "generated sql query", slog.String("query", query), slog.Any("arguments", args))
+
+	rows, err := r.database.ReadPool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		fnd := &storage.User{}
-		err = rows.Scan(
-			&fnd.ID,
-			&fnd.Name,
-			&fnd.CreatedAt,
-		)
+		var fnd storage.User
+		fnd, err = scanUser(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
@@ -80,8 +248,111 @@ func (r *DataReader) ReadUsers(ctx context.Context, pagination database.Paginati
 		return nil, fmt.Errorf("row iteration error: %w", err)
 	}
 
-	slog.DebugContext(ctx, "successfully retrieved and converted users from the database")
+	slog.DebugContext(ctx, "successfully retrieved users from the database")
 
-	// Return the results.
 	return users, nil
 }
+
+// ExportUsers streams the users matching the filter in id order through a server-side cursor, so neither the
+// database nor this process materializes the whole table. The cursor lives in a read only transaction on the
+// read pool and sees a consistent snapshot for the duration of the export.
+func (r *DataReader) ExportUsers(ctx context.Context, filter storage.UserFilter, fn func(user *basev1.User) error) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.export-users")
+	defer span.End()
+
+	slog.DebugContext(ctx, "exporting users")
+
+	query, args, err := r.database.Builder.
+		Select(UserColumns).
+		From(UsersTable).
+		Where(userFilter(tenancy.ID(ctx), filter)).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.database.ReadPool.BeginTx(ctx, r.txOptions)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	// The transaction only reads, rolling it back also closes the cursor.
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err = tx.Exec(ctx, "DECLARE users_export NO SCROLL CURSOR FOR "+query, args...); err != nil {
+		return fmt.Errorf("failed to declare cursor: %w", err)
+	}
+
+	var exported int
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM users_export", exportBatchSize)
+	for {
+		var users []storage.User
+		users, err = r.fetch(ctx, tx, fetch)
+		if err != nil {
+			return err
+		}
+		for _, u := range users {
+			if err = fn(u.ToProto()); err != nil {
+				return err
+			}
+		}
+		exported += len(users)
+		if len(users) < exportBatchSize {
+			break
+		}
+	}
+
+	slog.DebugContext(ctx, "successfully exported users from the database", slog.Int("count", exported))
+
+	return nil
+}
+
+// fetch reads the next batch of users from a cursor.
+func (r *DataReader) fetch(ctx context.Context, tx pgx.Tx, fetch string) ([]storage.User, error) {
+	rows, err := tx.Query(ctx, fetch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch from cursor: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]storage.User, 0, exportBatchSize)
+	for rows.Next() {
+		var fnd storage.User
+		fnd, err = scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		users = append(users, fnd)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return users, nil
+}
+
+// likeEscaper escapes the LIKE wildcards so search input is matched literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// userFilter builds the where clause of the filter within the tenant, all values are passed as arguments.
+func userFilter(tenantID uint64, filter storage.UserFilter) squirrel.And {
+	where := squirrel.And{squirrel.Eq{"tenant_id": tenantID}}
+	if !filter.ShowDeleted {
+		where = append(where, squirrel.Eq{"deleted_at": nil})
+	}
+	if filter.NamePrefix != "" {
+		where = append(where, squirrel.ILike{"name": likeEscaper.Replace(filter.NamePrefix) + "%"})
+	}
+	if filter.NameContains != "" {
+		where = append(where, squirrel.ILike{"name": "%" + likeEscaper.Replace(filter.NameContains) + "%"})
+	}
+	if !filter.CreatedAfter.IsZero() {
+		where = append(where, squirrel.GtOrEq{"created_at": filter.CreatedAfter})
+	}
+	if !filter.CreatedBefore.IsZero() {
+		where = append(where, squirrel.Lt{"created_at": filter.CreatedBefore})
+	}
+	return where
+}
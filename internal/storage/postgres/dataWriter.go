@@ -1,15 +1,27 @@
 package postgres
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
+	"time"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
 	db "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
 
 	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
 )
 
 // DataWriter - Structure for Data Writer
@@ -26,16 +38,19 @@ func NewDataWriter(database *db.Postgres) *DataWriter {
 	}
 }
 
-func (w *DataWriter) Write(ctx context.Context, name string) (err error) {
+// Write creates a new user and returns it, an external id that is already taken yields ERROR_CODE_ALREADY_EXIST.
+func (w *DataWriter) Write(ctx context.Context, externalID, name string) (user *basev1.User, err error) {
 	// Start a new trace span and end it when the function exits.
 	ctx, span := internal.Tracer.Start(ctx, "data-writer.write")
 	defer span.End()
 
 	slog.DebugContext(ctx, "write user")
 
+	tenantID := tenancy.ID(ctx)
+
 	tx, err := w.database.WritePool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer func() {
 		if p := recover(); p != nil {
@@ -49,20 +64,546 @@ func (w *DataWriter) Write(ctx context.Context, name string) (err error) {
 
 	// Build the SQL query using Squirrel
 	builder := w.database.Builder.
-		Insert("users").
-		Columns("name").
-		Values(name)
+		Insert(UsersTable).
+		Columns("tenant_id", "external_id", "name").
+		Values(tenantID, nullableExternalID(externalID), name).
+		Suffix("RETURNING " + UserColumns)
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to build SQL query: %w", err)
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
 	}
 
-	_, err = tx.Exec(ctx, query, args...)
+	fnd, err := scanUser(tx.QueryRow(ctx, query, args...))
 	if err != nil {
-		return fmt.Errorf("failed to insert user: %w", err)
+		if isUniqueViolation(err) {
+			return nil, apierrors.New(basev1.ErrorCode_ERROR_CODE_ALREADY_EXIST).WithMetadata("external_id", externalID)
+		}
+		if isForeignKeyViolation(err) {
+			return nil, unknownTenant(tenantID)
+		}
+		return nil, fmt.Errorf("failed to insert user: %w", err)
+	}
+
+	user = fnd.ToProto()
+	if err = w.record(ctx, tx, storage.UserCreated, user); err != nil {
+		return nil, err
 	}
 
 	slog.DebugContext(ctx, "successfully written user to the database")
+	return user, nil
+}
+
+// WriteBatch creates the users in one transaction. Without partial all users are inserted by a single multi-row
+// insert, with partial every user is inserted in its own savepoint so a failing user does not abort the others.
+func (w *DataWriter) WriteBatch(ctx context.Context, users []storage.User, partial bool) (results []storage.UserResult, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.write-batch")
+	defer span.End()
+
+	slog.DebugContext(ctx, "write users", slog.Int("count", len(users)))
+
+	// External ids repeated within the batch are rejected up front, the database would only report a
+	// unique violation without telling which user caused it.
+	results = make([]storage.UserResult, len(users))
+	seen := make(map[string]struct{}, len(users))
+	for i, u := range users {
+		if u.ExternalID == "" {
+			continue
+		}
+		if _, ok := seen[u.ExternalID]; ok {
+			e := apierrors.New(basev1.ErrorCode_ERROR_CODE_ALREADY_EXIST).WithMetadata("external_id", u.ExternalID)
+			if !partial {
+				return nil, e.WithMetadata("index", strconv.Itoa(i))
+			}
+			results[i].Err = e
+			continue
+		}
+		seen[u.ExternalID] = struct{}{}
+	}
+
+	tx, err := w.database.WritePool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+		} else if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	if partial {
+		err = w.writeEach(ctx, tx, users, results)
+	} else {
+		err = w.writeAll(ctx, tx, users, results)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	created := make([]*basev1.User, 0, len(results))
+	for _, result := range results {
+		if result.User != nil {
+			created = append(created, result.User)
+		}
+	}
+	if err = w.record(ctx, tx, storage.UserCreated, created...); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "successfully written users to the database")
+	return results, nil
+}
+
+// writeAll inserts all users with a single statement, any failure fails the whole batch.
+func (w *DataWriter) writeAll(ctx context.Context, tx pgx.Tx, users []storage.User, results []storage.UserResult) error {
+	tenantID := tenancy.ID(ctx)
+
+	builder := w.database.Builder.
+		Insert(UsersTable).
+		Columns("tenant_id", "external_id", "name").
+		Suffix("RETURNING " + UserColumns)
+	for _, u := range users {
+		builder = builder.Values(tenantID, nullableExternalID(u.ExternalID), u.Name)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert users: %w", err)
+	}
+	defer rows.Close()
+
+	var fetched []storage.User
+	for rows.Next() {
+		var fnd storage.User
+		fnd, err = scanUser(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+		fetched = append(fetched, fnd)
+	}
+	if err = rows.Err(); err != nil {
+		if isUniqueViolation(err) {
+			return w.taken(ctx, users)
+		}
+		if isForeignKeyViolation(err) {
+			return unknownTenant(tenantID)
+		}
+		return fmt.Errorf("failed to insert users: %w", err)
+	}
+	if len(fetched) != len(users) {
+		return fmt.Errorf("inserted %d users, expected %d", len(fetched), len(users))
+	}
+
+	// Ids are drawn from the sequence in the order of the values, sorting by id restores the request order.
+	slices.SortFunc(fetched, func(a, b storage.User) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	for i, fnd := range fetched {
+		results[i].User = fnd.ToProto()
+	}
+	return nil
+}
+
+// writeEach inserts every user in its own savepoint, users whose external id is taken are reported in their result.
+func (w *DataWriter) writeEach(ctx context.Context, tx pgx.Tx, users []storage.User, results []storage.UserResult) error {
+	tenantID := tenancy.ID(ctx)
+
+	for i, u := range users {
+		if results[i].Err != nil {
+			continue
+		}
+
+		query, args, err := w.database.Builder.
+			Insert(UsersTable).
+			Columns("tenant_id", "external_id", "name").
+			Values(tenantID, nullableExternalID(u.ExternalID), u.Name).
+			Suffix("RETURNING " + UserColumns).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build SQL query: %w", err)
+		}
+
+		// Begin on a transaction creates a savepoint, rolling it back keeps the outer transaction usable.
+		sp, err := tx.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create savepoint: %w", err)
+		}
+
+		fnd, err := scanUser(sp.QueryRow(ctx, query, args...))
+		if err != nil {
+			_ = sp.Rollback(ctx)
+			if isUniqueViolation(err) {
+				results[i].Err = apierrors.New(basev1.ErrorCode_ERROR_CODE_ALREADY_EXIST).WithMetadata("external_id", u.ExternalID)
+				continue
+			}
+			if isForeignKeyViolation(err) {
+				return unknownTenant(tenantID)
+			}
+			return fmt.Errorf("failed to insert user: %w", err)
+		}
+
+		if err = sp.Commit(ctx); err != nil {
+			return fmt.Errorf("failed to release savepoint: %w", err)
+		}
+		results[i].User = fnd.ToProto()
+	}
 	return nil
 }
+
+// taken returns the ERROR_CODE_ALREADY_EXIST of the first user whose external id is already stored.
+func (w *DataWriter) taken(ctx context.Context, users []storage.User) error {
+	var externalIDs []string
+	for _, u := range users {
+		if u.ExternalID != "" {
+			externalIDs = append(externalIDs, u.ExternalID)
+		}
+	}
+
+	query, args, err := w.database.Builder.
+		Select("external_id").
+		From(UsersTable).
+		Where(squirrel.Eq{"tenant_id": tenancy.ID(ctx), "external_id": externalIDs}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	rows, err := w.database.WritePool.Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query users: %w", err)
+	}
+	defer rows.Close()
+
+	existing := make(map[string]struct{})
+	for rows.Next() {
+		var externalID string
+		if err = rows.Scan(&externalID); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+		existing[externalID] = struct{}{}
+	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("row iteration error: %w", err)
+	}
+
+	for i, u := range users {
+		if _, ok := existing[u.ExternalID]; ok {
+			return apierrors.New(basev1.ErrorCode_ERROR_CODE_ALREADY_EXIST).
+				WithMetadata("external_id", u.ExternalID).
+				WithMetadata("index", strconv.Itoa(i))
+		}
+	}
+
+	// The conflicting user was purged in the meantime.
+	return apierrors.New(basev1.ErrorCode_ERROR_CODE_ALREADY_EXIST)
+}
+
+// Update changes the name of an existing user and returns the updated user, soft deleted users are not found.
+// A non-zero revision is compared in the same statement so concurrent updates can not both succeed.
+func (w *DataWriter) Update(ctx context.Context, id uint64, name string, revision uint64) (user *basev1.User, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.update")
+	defer span.End()
+
+	slog.DebugContext(ctx, "update user", slog.Uint64("id", id))
+
+	builder := w.database.Builder.
+		Update(UsersTable).
+		Set("name", name).
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(matchRevision(tenancy.ID(ctx), id, revision)).
+		Suffix("RETURNING " + UserColumns)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	tx, err := w.database.WritePool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+		} else if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	fnd, err := scanUser(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, w.missing(ctx, id)
+		}
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	user = fnd.ToProto()
+	if err = w.record(ctx, tx, storage.UserUpdated, user); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "successfully updated user in the database")
+	return user, nil
+}
+
+// Delete soft deletes an existing user by setting its deleted_at, deleting a deleted user yields ERROR_CODE_NOT_FOUND.
+// A non-zero revision is compared in the same statement like in Update.
+func (w *DataWriter) Delete(ctx context.Context, id uint64, revision uint64) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.delete")
+	defer span.End()
+
+	slog.DebugContext(ctx, "delete user", slog.Uint64("id", id))
+
+	builder := w.database.Builder.
+		Update(UsersTable).
+		Set("deleted_at", squirrel.Expr("now()")).
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(matchRevision(tenancy.ID(ctx), id, revision)).
+		Suffix("RETURNING " + UserColumns)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	tx, err := w.database.WritePool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+		} else if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	fnd, err := scanUser(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return w.missing(ctx, id)
+		}
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	if err = w.record(ctx, tx, storage.UserDeleted, fnd.ToProto()); err != nil {
+		return err
+	}
+
+	slog.DebugContext(ctx, "successfully deleted user from the database")
+	return nil
+}
+
+// Undelete clears the deleted_at of a user and returns it, restoring a user that is not deleted returns it unchanged.
+func (w *DataWriter) Undelete(ctx context.Context, id uint64) (user *basev1.User, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.undelete")
+	defer span.End()
+
+	slog.DebugContext(ctx, "undelete user", slog.Uint64("id", id))
+
+	builder := w.database.Builder.
+		Update(UsersTable).
+		Set("deleted_at", nil).
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(squirrel.And{squirrel.Eq{"id": id, "tenant_id": tenancy.ID(ctx)}, squirrel.NotEq{"deleted_at": nil}}).
+		Suffix("RETURNING " + UserColumns)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	tx, err := w.database.WritePool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+		} else if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	fnd, err := scanUser(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// The user either does not exist or is not deleted, in which case it is returned unchanged.
+			return w.current(ctx, id)
+		}
+		return nil, fmt.Errorf("failed to undelete user: %w", err)
+	}
+
+	user = fnd.ToProto()
+	if err = w.record(ctx, tx, storage.UserUndeleted, user); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "successfully undeleted user in the database")
+	return user, nil
+}
+
+// Purge permanently removes the users soft deleted before the given time.
+func (w *DataWriter) Purge(ctx context.Context, before time.Time) (purged int64, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.purge")
+	defer span.End()
+
+	// Soft deleted users of every tenant are purged.
+	ctx = tenancy.Unscoped(ctx)
+
+	slog.DebugContext(ctx, "purge users", slog.Time("before", before))
+
+	builder := w.database.Builder.
+		Delete(UsersTable).
+		Where(squirrel.Lt{"deleted_at": before})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	tag, err := w.database.WritePool.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge users: %w", err)
+	}
+
+	slog.DebugContext(ctx, "successfully purged users from the database", slog.Int64("count", tag.RowsAffected()))
+	return tag.RowsAffected(), nil
+}
+
+// current returns the user with the id as stored, deleted or not.
+func (w *DataWriter) current(ctx context.Context, id uint64) (*basev1.User, error) {
+	query, args, err := w.database.Builder.
+		Select(UserColumns).
+		From(UsersTable).
+		Where(squirrel.Eq{"id": id, "tenant_id": tenancy.ID(ctx)}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	fnd, err := scanUser(w.database.WritePool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND)
+		}
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
+	return fnd.ToProto(), nil
+}
+
+// record inserts the events of changes in the transaction making them, watchers are notified when it commits.
+// Ids are drawn when the events are inserted, so a transaction committing later can still add events with lower
+// ids than the ones already read. Instead of serializing writers on a lock until they commit, every event keeps
+// the id of its transaction in xact_id, and readers only take events below eventHorizon ordered by (xact_id, id):
+// once a transaction is below the horizon no event can be added before it. Writers run concurrently, the price
+// is that events wait for the oldest running transaction of the cluster to end before they are delivered.
+func (w *DataWriter) record(ctx context.Context, tx pgx.Tx, typ storage.UserEventType, users ...*basev1.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	builder := w.database.Builder.
+		Insert(UserEventsTable).
+		Columns("tenant_id", "type", "user_id", "payload")
+	for _, u := range users {
+		payload, err := protojson.Marshal(u)
+		if err != nil {
+			return fmt.Errorf("failed to encode user event payload: %w", err)
+		}
+		builder = builder.Values(u.GetTenantId(), string(typ), u.GetId(), string(payload))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert user events: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, "SELECT pg_notify($1, '')", UserEventsChannel); err != nil {
+		return fmt.Errorf("failed to notify user event watchers: %w", err)
+	}
+	return nil
+}
+
+// matchRevision selects the user with the id in the tenant unless it is soft deleted, a non-zero revision must
+// match as well.
+func matchRevision(tenantID, id, revision uint64) squirrel.Eq {
+	where := squirrel.Eq{"id": id, "tenant_id": tenantID, "deleted_at": nil}
+	if revision != 0 {
+		where["revision"] = revision
+	}
+	return where
+}
+
+// missing tells apart why a conditional write matched no row: the user either does not exist, which yields
+// ERROR_CODE_NOT_FOUND, or has another revision, which yields ERROR_CODE_CONFLICT with the current revision.
+func (w *DataWriter) missing(ctx context.Context, id uint64) error {
+	query, args, err := w.database.Builder.
+		Select("revision").
+		From(UsersTable).
+		Where(squirrel.Eq{"id": id, "tenant_id": tenancy.ID(ctx), "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	var current uint64
+	if err = w.database.WritePool.QueryRow(ctx, query, args...).Scan(&current); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND)
+		}
+		return fmt.Errorf("failed to scan row: %w", err)
+	}
+
+	return apierrors.New(basev1.ErrorCode_ERROR_CODE_CONFLICT).WithMetadata("revision", strconv.FormatUint(current, 10))
+}
+
+// nullableExternalID stores users created without an external id as NULL, which the unique index does not compare.
+func nullableExternalID(externalID string) interface{} {
+	if externalID == "" {
+		return nil
+	}
+	return externalID
+}
+
+// isUniqueViolation reports whether a unique index rejected the row.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
+
+// isForeignKeyViolation reports whether the row references a row that does not exist, for users the tenant.
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation
+}
+
+// unknownTenant is the error of a write to a tenant that does not exist.
+func unknownTenant(tenantID uint64) error {
+	return apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND).WithMetadata("tenant_id", strconv.FormatUint(tenantID, 10))
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+)
+
+// IdempotencyStore - Structure for Idempotency Store
+type IdempotencyStore struct {
+	database *db.Postgres
+}
+
+func NewIdempotencyStore(database *db.Postgres) *IdempotencyStore {
+	return &IdempotencyStore{
+		database: database,
+	}
+}
+
+// Reserve claims the key with a single upsert that only overwrites expired keys, so concurrent
+// retries of the same request cannot both reserve it.
+func (s *IdempotencyStore) Reserve(ctx context.Context, record storage.IdempotencyRecord) (existing *storage.IdempotencyRecord, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "idempotency-store.reserve")
+	defer span.End()
+
+	slog.DebugContext(ctx, "reserve idempotency key", slog.String("method", record.Method))
+
+	insert, insertArgs, err := s.database.Builder.
+		Insert(IdempotencyKeysTable).
+		Columns("key", "method", "request_hash", "expires_at").
+		Values(record.Key, record.Method, record.RequestHash, record.ExpiresAt).
+		Suffix(`ON CONFLICT (key) DO UPDATE SET method = EXCLUDED.method, request_hash = EXCLUDED.request_hash,
+			response = NULL, created_at = now(), completed_at = NULL, expires_at = EXCLUDED.expires_at
+			WHERE ` + IdempotencyKeysTable + `.expires_at < now() RETURNING key`).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	selectQuery, selectArgs, err := s.database.Builder.
+		Select("key, method, request_hash, response, created_at, completed_at, expires_at").
+		From(IdempotencyKeysTable).
+		Where(squirrel.Eq{"key": record.Key}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	// The existing key can be released between the upsert and the select, in which case the upsert is retried.
+	for attempt := 0; attempt < 3; attempt++ {
+		var key string
+		err = s.database.WritePool.QueryRow(ctx, insert, insertArgs...).Scan(&key)
+		if err == nil {
+			return nil, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+		}
+
+		fnd := storage.IdempotencyRecord{}
+		var completedAt *time.Time
+		err = s.database.WritePool.QueryRow(ctx, selectQuery, selectArgs...).Scan(
+			&fnd.Key,
+			&fnd.Method,
+			&fnd.RequestHash,
+			&fnd.Response,
+			&fnd.CreatedAt,
+			&completedAt,
+			&fnd.ExpiresAt,
+		)
+		if err == nil {
+			if completedAt != nil {
+				fnd.CompletedAt = *completedAt
+			}
+			return &fnd, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+	}
+
+	return nil, errors.New("failed to reserve idempotency key: key is contended")
+}
+
+// Complete stores the response of the request the key was reserved for, completed_at marks it done as the
+// response of an empty message is empty.
+func (s *IdempotencyStore) Complete(ctx context.Context, key string, response []byte) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "idempotency-store.complete")
+	defer span.End()
+
+	query, args, err := s.database.Builder.
+		Update(IdempotencyKeysTable).
+		Set("response", response).
+		Set("completed_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"key": key}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	if _, err = s.database.WritePool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release removes the reservation of a request that failed.
+func (s *IdempotencyStore) Release(ctx context.Context, key string) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "idempotency-store.release")
+	defer span.End()
+
+	query, args, err := s.database.Builder.
+		Delete(IdempotencyKeysTable).
+		Where(squirrel.Eq{"key": key}).
+		Where("completed_at IS NULL").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	if _, err = s.database.WritePool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired removes the keys that expired before the given time.
+func (s *IdempotencyStore) DeleteExpired(ctx context.Context, before time.Time) (deleted int64, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "idempotency-store.delete-expired")
+	defer span.End()
+
+	query, args, err := s.database.Builder.
+		Delete(IdempotencyKeysTable).
+		Where(squirrel.Lt{"expires_at": before}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	tag, err := s.database.WritePool.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/storage/postgres/instance"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	PQDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+)
+
+var _ = Describe("IdempotencyStore", func() {
+	var db database.Database
+	var store *IdempotencyStore
+
+	record := func(key string, ttl time.Duration) storage.IdempotencyRecord {
+		return storage.IdempotencyRecord{
+			Key:         key,
+			Method:      "/base.v1.UserService/Create",
+			RequestHash: []byte("hash"),
+			ExpiresAt:   time.Now().Add(ttl),
+		}
+	}
+
+	BeforeEach(func() {
+		version := os.Getenv("POSTGRES_VERSION")
+
+		if version == "" {
+			version = "14"
+		}
+
+		db = instance.PostgresDB(version, tenantSession)
+		store = NewIdempotencyStore(db.(*PQDatabase.Postgres))
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Reserve", func() {
+		It("reserves a new key", func() {
+			ctx := inDefaultTenant
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).Should(BeNil())
+		})
+
+		It("returns the pending record of a reserved key", func() {
+			ctx := inDefaultTenant
+
+			_, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).ShouldNot(BeNil())
+			Expect(existing.Pending()).Should(BeTrue())
+			Expect(existing.RequestHash).Should(Equal([]byte("hash")))
+		})
+
+		It("returns the stored response of a completed key", func() {
+			ctx := inDefaultTenant
+
+			_, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(store.Complete(ctx, "key-1", []byte("response"))).Should(Succeed())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).ShouldNot(BeNil())
+			Expect(existing.Response).Should(Equal([]byte("response")))
+		})
+
+		It("returns the empty response of a completed key", func() {
+			ctx := inDefaultTenant
+
+			_, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(store.Complete(ctx, "key-1", nil)).Should(Succeed())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).ShouldNot(BeNil())
+			Expect(existing.Pending()).Should(BeFalse())
+			Expect(existing.Response).Should(BeEmpty())
+		})
+
+		It("reserves an expired key again", func() {
+			ctx := inDefaultTenant
+
+			_, err := store.Reserve(ctx, record("key-1", -time.Minute))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).Should(BeNil())
+		})
+	})
+
+	Context("Release", func() {
+		It("frees a pending key", func() {
+			ctx := inDefaultTenant
+
+			_, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(store.Release(ctx, "key-1")).Should(Succeed())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).Should(BeNil())
+		})
+
+		It("keeps a completed key", func() {
+			ctx := inDefaultTenant
+
+			_, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(store.Complete(ctx, "key-1", []byte("response"))).Should(Succeed())
+			Expect(store.Release(ctx, "key-1")).Should(Succeed())
+
+			existing, err := store.Reserve(ctx, record("key-1", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existing).ShouldNot(BeNil())
+		})
+	})
+
+	Context("DeleteExpired", func() {
+		It("deletes only expired keys", func() {
+			ctx := inDefaultTenant
+
+			_, err := store.Reserve(ctx, record("key-1", -time.Minute))
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = store.Reserve(ctx, record("key-2", time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			deleted, err := store.DeleteExpired(ctx, time.Now())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(Equal(int64(1)))
+		})
+	})
+})
@@ -18,7 +18,9 @@ import (
 	"github.com/tolgaOzen/go-skeleton/internal/storage"
 )
 
-func PostgresDB(postgresVersion string) database.Database {
+// PostgresDB starts a postgres container, migrates it and connects to it as a role subject to row-level security,
+// the options are applied after the connection limits.
+func PostgresDB(postgresVersion string, opts ...PQDatabase.Option) database.Database {
 	ctx := context.Background()
 
 	image := fmt.Sprintf("postgres:%s-alpine", postgresVersion)
@@ -75,13 +77,25 @@ func PostgresDB(postgresVersion string) database.Database {
 	err = storage.Migrate(cfg)
 	Expect(err).ShouldNot(HaveOccurred())
 
+	// Superusers bypass row-level security, the storage connects as a role holding only the privileges it uses.
+	grants := "CREATE ROLE skeleton LOGIN PASSWORD 'skeleton';" +
+		" GRANT USAGE ON SCHEMA public TO skeleton;" +
+		" GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO skeleton;" +
+		" GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public TO skeleton;"
+	code, _, err := postgres.Exec(ctx, []string{"psql", "-U", "postgres", "-d", "skeleton", "-v", "ON_ERROR_STOP=1", "-c", grants})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(code).Should(Equal(0))
+
 	var db database.Database
-	db, err = PQDatabase.New(cfg.URI,
-		PQDatabase.MaxOpenConnections(cfg.MaxOpenConnections),
-		PQDatabase.MaxIdleConnections(cfg.MaxIdleConnections),
-		PQDatabase.MaxConnectionIdleTime(cfg.MaxConnectionIdleTime),
-		PQDatabase.MaxConnectionLifeTime(cfg.MaxConnectionLifetime),
+	db, err = PQDatabase.New(fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", "skeleton", "skeleton", dbAddr, "skeleton"),
+		append([]PQDatabase.Option{
+			PQDatabase.MaxOpenConnections(cfg.MaxOpenConnections),
+			PQDatabase.MaxIdleConnections(cfg.MaxIdleConnections),
+			PQDatabase.MaxConnectionIdleTime(cfg.MaxConnectionIdleTime),
+			PQDatabase.MaxConnectionLifeTime(cfg.MaxConnectionLifetime),
+		}, opts...)...,
 	)
+	Expect(err).ShouldNot(HaveOccurred())
 
 	return db
 }
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS idx_users_created_at_id ON users (created_at DESC, id DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_users_created_at_id;
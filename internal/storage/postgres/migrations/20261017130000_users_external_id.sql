@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN IF NOT EXISTS external_id VARCHAR(64);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_external_id ON users (external_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_users_external_id;
+ALTER TABLE users DROP COLUMN IF EXISTS external_id;
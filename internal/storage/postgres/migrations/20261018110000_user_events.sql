@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS user_events
+(
+    id         BIGSERIAL PRIMARY KEY,
+    type       TEXT        NOT NULL,
+    user_id    BIGINT      NOT NULL,
+    payload    JSONB       NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_events;
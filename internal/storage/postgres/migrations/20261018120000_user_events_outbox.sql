@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE user_events ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_user_events_pending ON user_events (id) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_user_events_published_at ON user_events (published_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_user_events_published_at;
+DROP INDEX IF EXISTS idx_user_events_pending;
+ALTER TABLE user_events DROP COLUMN IF EXISTS published_at;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS audit_events
+(
+    id             BIGSERIAL PRIMARY KEY,
+    actor          TEXT        NOT NULL,
+    method         TEXT        NOT NULL,
+    resource_id    TEXT        NOT NULL,
+    request_digest TEXT        NOT NULL,
+    code           TEXT        NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON audit_events (actor, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_events;
@@ -0,0 +1,53 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS tenants
+(
+    id         BIGSERIAL PRIMARY KEY,
+    name       VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tenants_name ON tenants (name);
+
+-- Users and events written before tenants existed belong to the default tenant.
+INSERT INTO tenants (id, name) VALUES (1, 'default') ON CONFLICT DO NOTHING;
+SELECT setval(pg_get_serial_sequence('tenants', 'id'), (SELECT MAX(id) FROM tenants));
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id) ON DELETE CASCADE;
+ALTER TABLE users ALTER COLUMN tenant_id DROP DEFAULT;
+
+-- External ids are only unique within a tenant.
+DROP INDEX IF EXISTS idx_users_external_id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_tenant_external_id ON users (tenant_id, external_id);
+
+DROP INDEX IF EXISTS idx_users_created_at_id;
+CREATE INDEX IF NOT EXISTS idx_users_tenant_created_at_id ON users (tenant_id, created_at DESC, id DESC);
+
+ALTER TABLE user_events ADD COLUMN IF NOT EXISTS tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id) ON DELETE CASCADE;
+ALTER TABLE user_events ALTER COLUMN tenant_id DROP DEFAULT;
+CREATE INDEX IF NOT EXISTS idx_user_events_tenant_id ON user_events (tenant_id, id);
+
+-- The audit trail outlives the tenants it records.
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS tenant_id BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE audit_events ALTER COLUMN tenant_id DROP DEFAULT;
+DROP INDEX IF EXISTS idx_audit_events_actor;
+CREATE INDEX IF NOT EXISTS idx_audit_events_tenant_actor ON audit_events (tenant_id, actor, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_tenant_id ON audit_events (tenant_id, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_audit_events_tenant_id;
+DROP INDEX IF EXISTS idx_audit_events_tenant_actor;
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON audit_events (actor, id);
+ALTER TABLE audit_events DROP COLUMN IF EXISTS tenant_id;
+
+DROP INDEX IF EXISTS idx_user_events_tenant_id;
+ALTER TABLE user_events DROP COLUMN IF EXISTS tenant_id;
+
+DROP INDEX IF EXISTS idx_users_tenant_created_at_id;
+CREATE INDEX IF NOT EXISTS idx_users_created_at_id ON users (created_at DESC, id DESC);
+
+DROP INDEX IF EXISTS idx_users_tenant_external_id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_external_id ON users (external_id);
+
+ALTER TABLE users DROP COLUMN IF EXISTS tenant_id;
+
+DROP TABLE IF EXISTS tenants;
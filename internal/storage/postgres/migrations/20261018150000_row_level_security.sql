@@ -0,0 +1,31 @@
+-- +goose Up
+-- Rows are only visible to connections whose app.tenant_id names their tenant, or every tenant with '*'.
+-- Connections that never set it see nothing. FORCE applies the policies to the owner of the tables as well,
+-- superusers and roles with BYPASSRLS still bypass them, so the server has to connect as neither.
+ALTER TABLE users ENABLE ROW LEVEL SECURITY;
+ALTER TABLE users FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON users
+    USING (current_setting('app.tenant_id', true) IN ('*', tenant_id::TEXT));
+
+ALTER TABLE user_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_events FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON user_events
+    USING (current_setting('app.tenant_id', true) IN ('*', tenant_id::TEXT));
+
+ALTER TABLE audit_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_events FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON audit_events
+    USING (current_setting('app.tenant_id', true) IN ('*', tenant_id::TEXT));
+
+-- +goose Down
+DROP POLICY IF EXISTS tenant_isolation ON audit_events;
+ALTER TABLE audit_events NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE audit_events DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON user_events;
+ALTER TABLE user_events NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE user_events DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON users;
+ALTER TABLE users NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE users DISABLE ROW LEVEL SECURITY;
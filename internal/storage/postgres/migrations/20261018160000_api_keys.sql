@@ -0,0 +1,25 @@
+-- +goose Up
+-- Only the SHA-256 digest of the secret is stored, keys are looked up by it while authenticating.
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id         BIGSERIAL PRIMARY KEY,
+    tenant_id  BIGINT      NOT NULL REFERENCES tenants (id) ON DELETE CASCADE,
+    name       VARCHAR(64) NOT NULL,
+    prefix     VARCHAR(16) NOT NULL,
+    hash       CHAR(64)    NOT NULL,
+    roles      TEXT[]      NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_hash ON api_keys (hash);
+CREATE INDEX IF NOT EXISTS idx_api_keys_tenant_id ON api_keys (tenant_id, id);
+
+ALTER TABLE api_keys ENABLE ROW LEVEL SECURITY;
+ALTER TABLE api_keys FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON api_keys
+    USING (current_setting('app.tenant_id', true) IN ('*', tenant_id::TEXT));
+
+-- +goose Down
+DROP TABLE IF EXISTS api_keys;
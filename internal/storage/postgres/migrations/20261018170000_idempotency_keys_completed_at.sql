@@ -0,0 +1,7 @@
+-- +goose Up
+-- Responses of empty messages are stored as no bytes, so completion is recorded on its own.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ;
+UPDATE idempotency_keys SET completed_at = created_at WHERE response IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS completed_at;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS replayed BOOLEAN NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE audit_events DROP COLUMN IF EXISTS replayed;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Events are ordered by the transaction that recorded them instead of a global lock, see DataWriter.record.
+-- Events recorded before were drawn in commit order under that lock, position 0 keeps them in id order.
+-- pg_current_xact_id needs PostgreSQL 13 or later.
+ALTER TABLE user_events ADD COLUMN IF NOT EXISTS xact_id BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE user_events ALTER COLUMN xact_id SET DEFAULT pg_current_xact_id()::TEXT::BIGINT;
+
+DROP INDEX IF EXISTS idx_user_events_tenant_id;
+CREATE INDEX IF NOT EXISTS idx_user_events_tenant_position ON user_events (tenant_id, xact_id, id);
+
+DROP INDEX IF EXISTS idx_user_events_pending;
+CREATE INDEX IF NOT EXISTS idx_user_events_pending ON user_events (xact_id, id) WHERE published_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_user_events_pending;
+CREATE INDEX IF NOT EXISTS idx_user_events_pending ON user_events (id) WHERE published_at IS NULL;
+
+DROP INDEX IF EXISTS idx_user_events_tenant_position;
+CREATE INDEX IF NOT EXISTS idx_user_events_tenant_id ON user_events (tenant_id, id);
+
+ALTER TABLE user_events DROP COLUMN IF EXISTS xact_id;
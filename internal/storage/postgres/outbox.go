@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+)
+
+// Outbox - Structure for Outbox, the user events table recorded by the DataWriter serves as the outbox
+type Outbox struct {
+	database *db.Postgres
+}
+
+func NewOutbox(database *db.Postgres) *Outbox {
+	return &Outbox{
+		database: database,
+	}
+}
+
+// Relay publishes the pending user events while holding the relay lock, so relays of several servers take
+// turns and events are published in order. Like watchers, relays only take the events below the horizon, an
+// event still being committed before them would otherwise be published out of order. The lock is held by the
+// session rather than a transaction: fn may take long, and an open transaction would hold the horizon back for
+// every watcher and relay meanwhile. Events are marked after fn accepted them, an event whose mark is lost is
+// published again: delivery is at least once.
+func (o *Outbox) Relay(ctx context.Context, limit int, fn func(event storage.UserEvent) error) (published int, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "outbox.relay")
+	defer span.End()
+
+	// The events of every tenant are relayed.
+	ctx = tenancy.Unscoped(ctx)
+
+	conn, err := o.database.WritePool.Acquire(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	var locked bool
+	if err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", relayLock).Scan(&locked); err != nil {
+		return 0, fmt.Errorf("failed to lock relay: %w", err)
+	}
+	if !locked {
+		slog.DebugContext(ctx, "another relay is publishing user events")
+		return 0, nil
+	}
+	// A connection going back to the pool must not keep the lock, it is closed when unlocking fails.
+	defer func() {
+		if _, uerr := conn.Exec(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock(hashtext($1))", relayLock); uerr != nil {
+			slog.WarnContext(ctx, "failed to unlock relay, closing the connection", slog.Any("error", uerr))
+			_ = conn.Conn().Close(context.WithoutCancel(ctx))
+		}
+	}()
+
+	query, args, err := o.database.Builder.
+		Select(UserEventColumns).
+		From(UserEventsTable).
+		Where(squirrel.Eq{"published_at": nil}).
+		Where(squirrel.Expr("xact_id < "+eventHorizon)).
+		OrderBy("xact_id", "id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query user events: %w", err)
+	}
+	events, err := collectUserEvents(rows)
+	if err != nil {
+		return 0, err
+	}
+
+	// The events accepted before a failure are still marked, the failing one is retried by the next relay.
+	var failure error
+	ids := make([]uint64, 0, len(events))
+	for _, event := range events {
+		if failure = fn(event); failure != nil {
+			break
+		}
+		ids = append(ids, event.ID)
+	}
+
+	if len(ids) > 0 {
+		query, args, err = o.database.Builder.
+			Update(UserEventsTable).
+			Set("published_at", squirrel.Expr("now()")).
+			Where(squirrel.Eq{"id": ids, "published_at": nil}).
+			ToSql()
+		if err != nil {
+			return 0, fmt.Errorf("failed to build SQL query: %w", err)
+		}
+		// Marking is a statement of its own, the accepted events are marked even when the relay was cancelled.
+		if _, err = conn.Exec(context.WithoutCancel(ctx), query, args...); err != nil {
+			return 0, fmt.Errorf("failed to mark user events: %w", err)
+		}
+	}
+
+	slog.DebugContext(ctx, "successfully relayed user events", slog.Int("count", len(ids)))
+	return len(ids), failure
+}
+
+// Cleanup removes the user events published before the given time except the latest one.
+func (o *Outbox) Cleanup(ctx context.Context, before time.Time) (deleted int64, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "outbox.cleanup")
+	defer span.End()
+
+	// The events of every tenant are cleaned up.
+	ctx = tenancy.Unscoped(ctx)
+
+	slog.DebugContext(ctx, "cleanup user events", slog.Time("before", before))
+
+	query, args, err := o.database.Builder.
+		Delete(UserEventsTable).
+		Where(squirrel.Lt{"published_at": before}).
+		Where(squirrel.Expr("(xact_id, id) < (SELECT xact_id, id FROM " + UserEventsTable + " ORDER BY xact_id DESC, id DESC LIMIT 1)")).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	tag, err := o.database.WritePool.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to cleanup user events: %w", err)
+	}
+
+	slog.DebugContext(ctx, "successfully cleaned up user events", slog.Int64("count", tag.RowsAffected()))
+	return tag.RowsAffected(), nil
+}
@@ -1,12 +1,24 @@
 package postgres
 
 import (
+	"context"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	PQDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
 )
 
+// tenantSession sets the tenant of the querying context on the connections of the test databases, as the
+// database factory does.
+var tenantSession = PQDatabase.SessionVariable(TenantSetting, TenantSession)
+
+// inDefaultTenant is the context of calls made in the default tenant, connections acquired for contexts without a
+// tenant see no rows.
+var inDefaultTenant = tenancy.NewContext(context.Background(), tenancy.DefaultTenant)
+
 func TestPostgres(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "postgres-suite")
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"os"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage/postgres/instance"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	PQDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+)
+
+var _ = Describe("RowLevelSecurity", func() {
+	var db database.Database
+	var dataWriter *DataWriter
+	var acme context.Context
+
+	BeforeEach(func() {
+		version := os.Getenv("POSTGRES_VERSION")
+
+		if version == "" {
+			version = "14"
+		}
+
+		db = instance.PostgresDB(version, tenantSession)
+		dataWriter = NewDataWriter(db.(*PQDatabase.Postgres))
+
+		tenant, err := NewTenantStore(db.(*PQDatabase.Postgres)).Create(inDefaultTenant, "acme")
+		Expect(err).ShouldNot(HaveOccurred())
+		acme = tenancy.NewContext(context.Background(), tenant.GetId())
+
+		_, err = dataWriter.Write(inDefaultTenant, "ext-1", "user-1")
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = dataWriter.Write(acme, "ext-1", "user-2")
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	count := func(ctx context.Context, q interface {
+		QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	}, table string,
+	) int {
+		var n int
+		err := q.QueryRow(ctx, "SELECT COUNT(*) FROM "+table).Scan(&n)
+		Expect(err).ShouldNot(HaveOccurred())
+		return n
+	}
+
+	It("hides every row from connections without the session variable", func() {
+		ctx := context.Background()
+
+		// A connection opened outside the pools never sets the variable.
+		conn, err := pgx.ConnectConfig(ctx, db.(*PQDatabase.Postgres).ReadPool.Config().ConnConfig)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer conn.Close(ctx)
+
+		Expect(count(ctx, conn, UsersTable)).Should(Equal(0))
+		Expect(count(ctx, conn, UserEventsTable)).Should(Equal(0))
+
+		_, err = conn.Exec(ctx, "SELECT set_config($1, $2, false)", TenantSetting, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(count(ctx, conn, UsersTable)).Should(Equal(0))
+	})
+
+	It("limits queries without a tenant condition to the tenant of the context", func() {
+		pool := db.(*PQDatabase.Postgres).ReadPool
+
+		Expect(count(inDefaultTenant, pool, UsersTable)).Should(Equal(1))
+		Expect(count(acme, pool, UsersTable)).Should(Equal(1))
+		Expect(count(acme, pool, UserEventsTable)).Should(Equal(1))
+		Expect(count(tenancy.Unscoped(context.Background()), pool, UsersTable)).Should(Equal(2))
+	})
+
+	It("hides every row from contexts that carry no tenant", func() {
+		ctx := context.Background()
+
+		Expect(count(ctx, db.(*PQDatabase.Postgres).ReadPool, UsersTable)).Should(Equal(0))
+		Expect(count(ctx, db.(*PQDatabase.Postgres).ReadPool, UserEventsTable)).Should(Equal(0))
+
+		// Stores scope a context without a tenant to the default one, the policies reject that as well.
+		_, err := dataWriter.Write(ctx, "ext-2", "user-3")
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("rejects rows written for another tenant", func() {
+		_, err := db.(*PQDatabase.Postgres).WritePool.Exec(acme,
+			"INSERT INTO "+UsersTable+" (tenant_id, external_id, name) VALUES ($1, $2, $3)", tenancy.DefaultTenant, "ext-2", "user-3")
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// scanUser scans a row selected with UserColumns.
+func scanUser(row pgx.Row) (storage.User, error) {
+	var u storage.User
+	var deletedAt *time.Time
+	err := row.Scan(
+		&u.ID,
+		&u.TenantID,
+		&u.ExternalID,
+		&u.Name,
+		&u.CreatedAt,
+		&deletedAt,
+		&u.Revision,
+	)
+	if deletedAt != nil {
+		u.DeletedAt = *deletedAt
+	}
+	return u, err
+}
+
+// scanUserEvent scans a row selected with UserEventColumns, followed by the extra columns selected after them.
+func scanUserEvent(row pgx.Row, extra ...any) (storage.UserEvent, error) {
+	var e storage.UserEvent
+	var payload []byte
+	var publishedAt *time.Time
+	if err := row.Scan(append([]any{&e.ID, &e.Type, &payload, &e.CreatedAt, &publishedAt}, extra...)...); err != nil {
+		return e, err
+	}
+	if publishedAt != nil {
+		e.PublishedAt = *publishedAt
+	}
+	e.User = &basev1.User{}
+	if err := protojson.Unmarshal(payload, e.User); err != nil {
+		return e, fmt.Errorf("failed to decode user event payload: %w", err)
+	}
+	return e, nil
+}
+
+// collectUserEvents scans all rows selected with UserEventColumns and closes them.
+func collectUserEvents(rows pgx.Rows) ([]storage.UserEvent, error) {
+	defer rows.Close()
+
+	var events []storage.UserEvent
+	for rows.Next() {
+		event, err := scanUserEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return events, nil
+}
+
+// scanAuditEvent scans a row selected with AuditEventColumns.
+func scanAuditEvent(row pgx.Row) (storage.AuditEvent, error) {
+	var e storage.AuditEvent
+	err := row.Scan(
+		&e.ID,
+		&e.TenantID,
+		&e.Actor,
+		&e.Method,
+		&e.ResourceID,
+		&e.RequestDigest,
+		&e.Code,
+		&e.Replayed,
+		&e.CreatedAt,
+	)
+	return e, err
+}
+
+// scanTenant scans a row selected with TenantColumns.
+func scanTenant(row pgx.Row) (storage.Tenant, error) {
+	var t storage.Tenant
+	err := row.Scan(
+		&t.ID,
+		&t.Name,
+		&t.CreatedAt,
+	)
+	return t, err
+}
+
+// scanApiKey scans a row selected with ApiKeyColumns.
+func scanApiKey(row pgx.Row) (storage.ApiKey, error) {
+	var k storage.ApiKey
+	var expiresAt, revokedAt *time.Time
+	err := row.Scan(
+		&k.ID,
+		&k.TenantID,
+		&k.Name,
+		&k.Prefix,
+		&k.Hash,
+		&k.Roles,
+		&k.CreatedAt,
+		&expiresAt,
+		&revokedAt,
+	)
+	if expiresAt != nil {
+		k.ExpiresAt = *expiresAt
+	}
+	if revokedAt != nil {
+		k.RevokedAt = *revokedAt
+	}
+	return k, err
+}
@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+)
+
+// TenantSession returns the value of TenantSetting for the context acquiring a connection, the tenant it carries
+// or every tenant for unscoped contexts. Set on every connection with PQDatabase.SessionVariable, it lets the
+// row-level security policies keep tenants apart should a query miss its tenant_id condition. Contexts that carry
+// no tenant get no value and see no rows, rather than the rows of the default tenant.
+func TenantSession(ctx context.Context) string {
+	if tenancy.IsUnscoped(ctx) {
+		return allTenants
+	}
+	if tenantID, ok := tenancy.FromContext(ctx); ok {
+		return strconv.FormatUint(tenantID, 10)
+	}
+	return ""
+}
@@ -0,0 +1,25 @@
+package postgres
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+)
+
+var _ = Describe("TenantSession", func() {
+	It("names the tenant of the context", func() {
+		Expect(TenantSession(tenancy.NewContext(context.Background(), 7))).Should(Equal("7"))
+		Expect(TenantSession(inDefaultTenant)).Should(Equal("1"))
+	})
+
+	It("names every tenant for unscoped contexts", func() {
+		Expect(TenantSession(tenancy.Unscoped(context.Background()))).Should(Equal(allTenants))
+	})
+
+	It("names no tenant for contexts that carry none", func() {
+		Expect(TenantSession(context.Background())).Should(BeEmpty())
+	})
+})
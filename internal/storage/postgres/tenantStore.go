@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// TenantStore - Structure for Tenant Store
+type TenantStore struct {
+	database *db.Postgres
+}
+
+func NewTenantStore(database *db.Postgres) *TenantStore {
+	return &TenantStore{
+		database: database,
+	}
+}
+
+// Create creates a tenant, a name that is already taken yields ERROR_CODE_ALREADY_EXIST.
+func (s *TenantStore) Create(ctx context.Context, name string) (tenant *basev1.Tenant, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "tenant-store.create")
+	defer span.End()
+
+	slog.DebugContext(ctx, "create tenant", slog.String("name", name))
+
+	query, args, err := s.database.Builder.
+		Insert(TenantsTable).
+		Columns("name").
+		Values(name).
+		Suffix("RETURNING " + TenantColumns).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	fnd, err := scanTenant(s.database.WritePool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, apierrors.New(basev1.ErrorCode_ERROR_CODE_ALREADY_EXIST).WithMetadata("name", name)
+		}
+		return nil, fmt.Errorf("failed to insert tenant: %w", err)
+	}
+	return fnd.ToProto(), nil
+}
+
+// List returns the tenants in id order, starting after the id of the token.
+func (s *TenantStore) List(ctx context.Context, pagination database.Pagination) (tenants []*basev1.Tenant, ct database.EncodedContinuousToken, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "tenant-store.list")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying tenants")
+
+	builder := s.database.Builder.
+		Select(TenantColumns).
+		From(TenantsTable).
+		OrderBy("id").
+		Limit(uint64(pagination.Size()) + 1)
+
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = database.NewEncodedContinuousToken(pagination.Token()).Decode(storage.TenantOrder)
+		if err != nil {
+			return nil, database.NewEncodedContinuousToken(""), apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+		}
+		builder = builder.Where(squirrel.Gt{"id": t.ID})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	var rows pgx.Rows
+	rows, err = s.database.ReadPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, database.NewEncodedContinuousToken(""), err
+	}
+	defer rows.Close()
+
+	var fetched []storage.Tenant
+	for rows.Next() {
+		var fnd storage.Tenant
+		fnd, err = scanTenant(rows)
+		if err != nil {
+			return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("failed to scan row: %w", err)
+		}
+		fetched = append(fetched, fnd)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, database.NewEncodedContinuousToken(""), fmt.Errorf("row iteration error: %w", err)
+	}
+
+	ct = database.NewEncodedContinuousToken("")
+	if uint32(len(fetched)) > pagination.Size() {
+		fetched = fetched[:pagination.Size()]
+		if len(fetched) > 0 {
+			ct = database.NewContinuousToken(storage.TenantOrder, "", fetched[len(fetched)-1].ID).Encode()
+		}
+	}
+
+	for _, fnd := range fetched {
+		tenants = append(tenants, fnd.ToProto())
+	}
+
+	return tenants, ct, nil
+}
+
+// Delete removes the tenant, its users and their events are removed by the cascading foreign keys.
+func (s *TenantStore) Delete(ctx context.Context, id uint64) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "tenant-store.delete")
+	defer span.End()
+
+	slog.DebugContext(ctx, "delete tenant", slog.Uint64("id", id))
+
+	// Data written without a tenant belongs to the default one, it has to outlive every other tenant.
+	if id == tenancy.DefaultTenant {
+		return apierrors.New(basev1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT).WithMessage("the default tenant can not be deleted")
+	}
+
+	query, args, err := s.database.Builder.
+		Delete(TenantsTable).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	tag, err := s.database.WritePool.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete tenant: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND).WithMetadata("tenant_id", strconv.FormatUint(id, 10))
+	}
+
+	slog.DebugContext(ctx, "successfully deleted tenant from the database")
+	return nil
+}
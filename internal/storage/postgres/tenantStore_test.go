@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/storage/postgres/instance"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	"github.com/tolgaOzen/go-skeleton/pkg/database"
+	PQDatabase "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+var _ = Describe("TenantStore", func() {
+	var db database.Database
+	var store *TenantStore
+	var dataWriter *DataWriter
+	var dataReader *DataReader
+
+	BeforeEach(func() {
+		version := os.Getenv("POSTGRES_VERSION")
+
+		if version == "" {
+			version = "14"
+		}
+
+		db = instance.PostgresDB(version, tenantSession)
+		store = NewTenantStore(db.(*PQDatabase.Postgres))
+		dataWriter = NewDataWriter(db.(*PQDatabase.Postgres))
+		dataReader = NewDataReader(db.(*PQDatabase.Postgres))
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	page := func(size uint32, token string) database.Pagination {
+		return database.NewPagination(database.Size(size), database.Token(token), database.OrderBy(storage.TenantOrder))
+	}
+
+	Context("Create", func() {
+		It("creates tenants after the default one", func() {
+			tenant, err := store.Create(inDefaultTenant, "acme")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetId()).Should(BeNumerically(">", uint64(1)))
+			Expect(tenant.GetName()).Should(Equal("acme"))
+		})
+
+		It("rejects a taken name", func() {
+			_, err := store.Create(inDefaultTenant, "default")
+			Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_ALREADY_EXIST))
+		})
+	})
+
+	Context("List", func() {
+		It("lists the tenants across pages", func() {
+			ctx := inDefaultTenant
+
+			for _, name := range []string{"acme", "globex"} {
+				_, err := store.Create(ctx, name)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			tenants, ct, err := store.List(ctx, page(2, ""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenants).Should(HaveLen(2))
+			Expect(tenants[0].GetName()).Should(Equal("default"))
+			Expect(tenants[1].GetName()).Should(Equal("acme"))
+			Expect(ct.String()).ShouldNot(BeEmpty())
+
+			tenants, ct, err = store.List(ctx, page(2, ct.String()))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenants).Should(HaveLen(1))
+			Expect(tenants[0].GetName()).Should(Equal("globex"))
+			Expect(ct.String()).Should(BeEmpty())
+		})
+	})
+
+	Context("Delete", func() {
+		It("deletes the tenant along with its users", func() {
+			tenant, err := store.Create(inDefaultTenant, "acme")
+			Expect(err).ShouldNot(HaveOccurred())
+			ctx := tenancy.NewContext(context.Background(), tenant.GetId())
+
+			user, err := dataWriter.Write(ctx, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			kept, err := dataWriter.Write(inDefaultTenant, "ext-1", "user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = store.Delete(inDefaultTenant, tenant.GetId())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataReader.ReadUser(ctx, user.GetId())
+			Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND))
+			_, err = dataReader.ReadUser(inDefaultTenant, kept.GetId())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(ctx, "ext-2", "user-2")
+			Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND))
+		})
+
+		It("fails for an unknown tenant", func() {
+			err := store.Delete(inDefaultTenant, 42)
+			Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_FOUND))
+		})
+
+		It("refuses to delete the default tenant", func() {
+			err := store.Delete(inDefaultTenant, tenancy.DefaultTenant)
+			Expect(apierrors.FromError(err).Code).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT))
+		})
+	})
+})
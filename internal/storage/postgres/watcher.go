@@ -0,0 +1,278 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/tenancy"
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
+	db "github.com/tolgaOzen/go-skeleton/pkg/database/postgres"
+	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// Watcher - Structure for Watcher
+type Watcher struct {
+	database *db.Postgres
+	// options
+	pollInterval time.Duration
+
+	mu          sync.Mutex
+	subscribers int
+	stop        context.CancelFunc
+	changed     chan struct{} // closed and replaced whenever user events were committed
+}
+
+func NewWatcher(database *db.Postgres) *Watcher {
+	return &Watcher{
+		database:     database,
+		pollInterval: watchPollInterval,
+		changed:      make(chan struct{}),
+	}
+}
+
+// position orders user events by the transaction that recorded them, then by id, see DataWriter.record.
+type position struct {
+	xactID uint64
+	id     uint64
+}
+
+// Head returns the id of the latest user event of the tenant below the horizon, 0 when there is none yet. Events
+// above the horizon are delivered to a watch started after it.
+func (w *Watcher) Head(ctx context.Context) (id uint64, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "watcher.head")
+	defer span.End()
+
+	query, args, err := w.database.Builder.
+		Select("id").
+		From(UserEventsTable).
+		Where(squirrel.Eq{"tenant_id": tenancy.ID(ctx)}).
+		Where(squirrel.Expr("xact_id < "+eventHorizon)).
+		OrderBy("xact_id DESC", "id DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	// The horizon is only meaningful on the primary, a replica would hand out a stale one.
+	if err = w.database.WritePool.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to scan row: %w", err)
+	}
+	return id, nil
+}
+
+// Watch calls fn for the user events of the tenant after the given id, then waits until it is notified that new events were
+// committed and repeats. All watchers of the process share a single listening connection, which is opened by
+// the first watcher and closed when the last one leaves.
+func (w *Watcher) Watch(ctx context.Context, after uint64, fn func(event storage.UserEvent) error) (err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "watcher.watch")
+	defer span.End()
+
+	slog.DebugContext(ctx, "watching user events", slog.Uint64("after", after))
+
+	// The cursor is kept as a position, the event it started from may be cleaned up while watching.
+	var cursor position
+	if after != 0 {
+		if cursor, err = w.retained(ctx, after); err != nil {
+			return err
+		}
+	}
+
+	w.subscribe()
+	defer w.unsubscribe()
+
+	for {
+		// Take the channel before reading, a notification arriving during the read closes it and
+		// the next round picks the new events up.
+		changed := w.wait()
+
+		var events []storage.UserEvent
+		var positions []position
+		events, positions, err = w.read(ctx, cursor)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		for i, event := range events {
+			if err = fn(event); err != nil {
+				return err
+			}
+			cursor = positions[i]
+		}
+
+		if len(events) == watchBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+// retained returns the position of the event a watcher resumes after, unless it was cleaned up. Events are
+// published and so cleaned up in position order, the events after it are still there as well.
+func (w *Watcher) retained(ctx context.Context, id uint64) (position, error) {
+	query, args, err := w.database.Builder.
+		Select("xact_id").
+		From(UserEventsTable).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return position{}, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	p := position{id: id}
+	if err = w.database.WritePool.QueryRow(ctx, query, args...).Scan(&p.xactID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return position{}, apierrors.New(basev1.ErrorCode_ERROR_CODE_RESUME_TOKEN_EXPIRED)
+		}
+		return position{}, fmt.Errorf("failed to scan row: %w", err)
+	}
+	return p, nil
+}
+
+// read returns the next batch of user events of the tenant after the given position and their positions. Only
+// events below the horizon are read, so no event can be committed before the last one read afterwards.
+func (w *Watcher) read(ctx context.Context, after position) ([]storage.UserEvent, []position, error) {
+	query, args, err := w.database.Builder.
+		Select(UserEventColumns, "xact_id").
+		From(UserEventsTable).
+		Where(squirrel.Eq{"tenant_id": tenancy.ID(ctx)}).
+		Where(squirrel.Expr("(xact_id, id) > (?, ?)", after.xactID, after.id)).
+		Where(squirrel.Expr("xact_id < "+eventHorizon)).
+		OrderBy("xact_id", "id").
+		Limit(watchBatchSize).
+		ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	// Events are read from the write pool, a replica lagging behind would delay them.
+	rows, err := w.database.WritePool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query user events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []storage.UserEvent
+	var positions []position
+	for rows.Next() {
+		var p position
+		event, err := scanUserEvent(rows, &p.xactID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		p.id = event.ID
+		events = append(events, event)
+		positions = append(positions, p)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return events, positions, nil
+}
+
+// subscribe registers a watcher and starts listening for notifications when it is the first one.
+func (w *Watcher) subscribe() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.subscribers++
+	if w.subscribers == 1 {
+		ctx, cancel := context.WithCancel(context.Background())
+		w.stop = cancel
+		go w.listen(ctx)
+	}
+}
+
+// unsubscribe removes a watcher and stops listening when it was the last one.
+func (w *Watcher) unsubscribe() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.subscribers--
+	if w.subscribers == 0 {
+		w.stop()
+	}
+}
+
+// wait returns the channel closed by the next notification.
+func (w *Watcher) wait() <-chan struct{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.changed
+}
+
+// broadcast wakes up all watchers.
+func (w *Watcher) broadcast() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	close(w.changed)
+	w.changed = make(chan struct{})
+}
+
+// listen keeps a connection listening on the user events channel until the context is done, reconnecting
+// after failures. Watchers poll on their own in the meantime.
+func (w *Watcher) listen(ctx context.Context) {
+	for {
+		err := w.listenOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		slog.WarnContext(ctx, "listening for user events failed, retrying", slog.Any("error", err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+// listenOnce listens on a dedicated connection and broadcasts every notification. The connection is taken
+// out of the pool and closed afterwards so no pooled connection is left listening.
+func (w *Watcher) listenOnce(ctx context.Context) error {
+	pooled, err := w.database.WritePool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	conn := pooled.Hijack()
+	defer func() {
+		_ = conn.Close(context.Background())
+	}()
+
+	if _, err = conn.Exec(ctx, "LISTEN "+UserEventsChannel); err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	// Events committed while no connection was listening have not been notified.
+	w.broadcast()
+
+	for {
+		if _, err = conn.WaitForNotification(ctx); err != nil {
+			return fmt.Errorf("failed to wait for notification: %w", err)
+		}
+		w.broadcast()
+	}
+}
@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/tolgaOzen/go-skeleton/internal"
+)
+
+// Purger - Permanently removes soft deleted users once their retention period has passed.
+type Purger struct {
+	writer    DataWriter
+	retention time.Duration
+
+	purged   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+// NewPurger - Creates a new purger removing users deleted longer than retention ago
+func NewPurger(writer DataWriter, retention time.Duration) (*Purger, error) {
+	purged, err := internal.Meter.Int64Counter("users_purged", metric.WithDescription("Number of soft deleted users permanently removed"))
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := internal.Meter.Float64Histogram("user_purge_duration", metric.WithDescription("Duration of a purge run"), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Purger{
+		writer:    writer,
+		retention: retention,
+		purged:    purged,
+		duration:  duration,
+	}, nil
+}
+
+// Run - Purges every interval until the context is cancelled
+func (p *Purger) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			purged, err := p.Purge(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to purge deleted users", slog.Any("error", err))
+				continue
+			}
+			if purged > 0 {
+				slog.InfoContext(ctx, "purged deleted users", slog.Int64("count", purged))
+			}
+		}
+	}
+}
+
+// Purge - Permanently removes the users deleted longer than the retention period ago
+func (p *Purger) Purge(ctx context.Context) (purged int64, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "purger.purge")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "failure"
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		p.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attribute.String("status", status)))
+	}()
+
+	before := start.Add(-p.retention).UTC()
+	span.SetAttributes(attribute.String("before", before.Format(time.RFC3339)))
+
+	purged, err = p.writer.Purge(ctx, before)
+	if err != nil {
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int64("purged", purged))
+	p.purged.Add(ctx, purged)
+
+	return purged, nil
+}
@@ -2,15 +2,28 @@ package storage
 
 import (
 	"context"
+	"time"
 
+	"github.com/tolgaOzen/go-skeleton/pkg/apierrors"
 	"github.com/tolgaOzen/go-skeleton/pkg/database"
 	basev1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
 )
 
-// DataReader - Interface for reading Data from the storage.
+// DataReader - Interface for reading Data from the storage. Only the users of the tenant carried by the
+// context are seen, see tenancy.ID.
 type DataReader interface {
-	// ReadUsers - Read users from the storage.
-	ReadUsers(ctx context.Context, pagination database.Pagination) (users []*basev1.User, err error)
+	// ReadUsers - Read the users matching the filter from the storage, ct is empty when there are no more pages.
+	ReadUsers(ctx context.Context, filter UserFilter, pagination database.Pagination) (users []*basev1.User, ct database.EncodedContinuousToken, err error)
+	// CountUsers - Count the users matching the filter across all pages.
+	CountUsers(ctx context.Context, filter UserFilter) (count int64, err error)
+	// ReadUser - Read a single user by its id from the storage, deleted users are not found.
+	ReadUser(ctx context.Context, id uint64) (user *basev1.User, err error)
+	// ReadUsersByIDs - Read the users with the given ids from the storage in no particular order,
+	// ids that are not found or deleted are left out.
+	ReadUsersByIDs(ctx context.Context, ids []uint64) (users []*basev1.User, err error)
+	// ExportUsers - Call fn for every user matching the filter in id order without holding all of them in
+	// memory, an error returned by fn stops the export and is returned.
+	ExportUsers(ctx context.Context, filter UserFilter, fn func(user *basev1.User) error) (err error)
 }
 
 type NoopDataReader struct{}
@@ -19,12 +32,45 @@ func NewNoopDataReader() DataReader {
 	return &NoopDataReader{}
 }
 
-func (f *NoopDataReader) ReadUsers(_ context.Context, _ database.Pagination) ([]*basev1.User, error) {
+func (f *NoopDataReader) ReadUsers(_ context.Context, _ UserFilter, _ database.Pagination) ([]*basev1.User, database.EncodedContinuousToken, error) {
+	return []*basev1.User{}, database.NewEncodedContinuousToken(""), nil
+}
+
+func (f *NoopDataReader) CountUsers(_ context.Context, _ UserFilter) (int64, error) {
+	return 0, nil
+}
+
+func (f *NoopDataReader) ReadUser(_ context.Context, _ uint64) (*basev1.User, error) {
+	return &basev1.User{}, nil
+}
+
+func (f *NoopDataReader) ReadUsersByIDs(_ context.Context, _ []uint64) ([]*basev1.User, error) {
 	return []*basev1.User{}, nil
 }
 
+func (f *NoopDataReader) ExportUsers(_ context.Context, _ UserFilter, _ func(*basev1.User) error) error {
+	return nil
+}
+
+// DataWriter - Interface for writing Data to the storage. Users are created in and only changed within the
+// tenant carried by the context, writing to a tenant that does not exist yields ERROR_CODE_NOT_FOUND.
 type DataWriter interface {
-	Write(ctx context.Context, name string) (err error)
+	// Write - Create a new user with the client supplied external id and return the created user.
+	Write(ctx context.Context, externalID, name string) (user *basev1.User, err error)
+	// WriteBatch - Create the users in one transaction and return a result per user, in order. Without partial
+	// no user is created when one fails and the error of the first failing user is returned with its index
+	// in the "index" metadata. With partial the failing users are skipped and reported in their results.
+	WriteBatch(ctx context.Context, users []User, partial bool) (results []UserResult, err error)
+	// Update - Update the name of an existing user and return the updated user. A non-zero revision must
+	// match the current revision of the user, otherwise ERROR_CODE_CONFLICT is returned.
+	Update(ctx context.Context, id uint64, name string, revision uint64) (user *basev1.User, err error)
+	// Delete - Soft delete an existing user, the user is kept until it is purged. A non-zero revision must
+	// match the current revision of the user, otherwise ERROR_CODE_CONFLICT is returned.
+	Delete(ctx context.Context, id uint64, revision uint64) (err error)
+	// Undelete - Restore a soft deleted user and return the restored user.
+	Undelete(ctx context.Context, id uint64) (user *basev1.User, err error)
+	// Purge - Permanently remove the users soft deleted before the given time, across all tenants.
+	Purge(ctx context.Context, before time.Time) (purged int64, err error)
 }
 
 type NoopDataWriter struct{}
@@ -33,6 +79,215 @@ func NewNoopDataWriter() DataWriter {
 	return &NoopDataWriter{}
 }
 
-func (n *NoopDataWriter) Write(_ context.Context, _ string) error {
+func (n *NoopDataWriter) Write(_ context.Context, _, _ string) (*basev1.User, error) {
+	return &basev1.User{}, nil
+}
+
+func (n *NoopDataWriter) WriteBatch(_ context.Context, users []User, _ bool) ([]UserResult, error) {
+	results := make([]UserResult, len(users))
+	for i := range users {
+		results[i] = UserResult{User: &basev1.User{}}
+	}
+	return results, nil
+}
+
+func (n *NoopDataWriter) Update(_ context.Context, _ uint64, _ string, _ uint64) (*basev1.User, error) {
+	return &basev1.User{}, nil
+}
+
+func (n *NoopDataWriter) Delete(_ context.Context, _, _ uint64) error {
+	return nil
+}
+
+func (n *NoopDataWriter) Undelete(_ context.Context, _ uint64) (*basev1.User, error) {
+	return &basev1.User{}, nil
+}
+
+func (n *NoopDataWriter) Purge(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+// Watcher - Interface for following the changes made to users of the tenant carried by the context.
+type Watcher interface {
+	// Head - Id of the latest user event, watching after it only yields the changes made from now on.
+	Head(ctx context.Context) (id uint64, err error)
+	// Watch - Call fn for every user event after the given id in transaction order and keep waiting for new events.
+	// Watching ends without error when the context is done, an error returned by fn ends it and is returned.
+	// When the event with the given id was cleaned up ERROR_CODE_RESUME_TOKEN_EXPIRED is returned.
+	Watch(ctx context.Context, after uint64, fn func(event UserEvent) error) (err error)
+}
+
+type NoopWatcher struct{}
+
+func NewNoopWatcher() Watcher {
+	return &NoopWatcher{}
+}
+
+func (n *NoopWatcher) Head(_ context.Context) (uint64, error) {
+	return 0, nil
+}
+
+func (n *NoopWatcher) Watch(ctx context.Context, _ uint64, _ func(UserEvent) error) error {
+	<-ctx.Done()
+	return nil
+}
+
+// Outbox - Interface for relaying the recorded user events to other systems.
+type Outbox interface {
+	// Relay - Call fn for at most limit user events that were not published yet, in transaction order, and mark the
+	// events fn accepted as published. The first error of fn stops the relay and is returned with the number of
+	// events published before it. Only one relay runs at a time, a relay started meanwhile publishes nothing.
+	Relay(ctx context.Context, limit int, fn func(event UserEvent) error) (published int, err error)
+	// Cleanup - Remove the user events published before the given time, the latest event is always kept so
+	// watchers that are up to date can resume.
+	Cleanup(ctx context.Context, before time.Time) (deleted int64, err error)
+}
+
+type NoopOutbox struct{}
+
+func NewNoopOutbox() Outbox {
+	return &NoopOutbox{}
+}
+
+func (n *NoopOutbox) Relay(_ context.Context, _ int, _ func(UserEvent) error) (int, error) {
+	return 0, nil
+}
+
+func (n *NoopOutbox) Cleanup(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+// TenantStore - Interface for managing the tenants users are kept apart by.
+type TenantStore interface {
+	// Create - Create a tenant, a name that is already taken yields ERROR_CODE_ALREADY_EXIST.
+	Create(ctx context.Context, name string) (tenant *basev1.Tenant, err error)
+	// List - Tenants in id order, paginated with continuous tokens.
+	List(ctx context.Context, pagination database.Pagination) (tenants []*basev1.Tenant, ct database.EncodedContinuousToken, err error)
+	// Delete - Remove the tenant together with its users and their events, the audit trail is kept. The default
+	// tenant can not be deleted.
+	Delete(ctx context.Context, id uint64) (err error)
+}
+
+type NoopTenantStore struct{}
+
+func NewNoopTenantStore() TenantStore {
+	return &NoopTenantStore{}
+}
+
+func (n *NoopTenantStore) Create(_ context.Context, _ string) (*basev1.Tenant, error) {
+	return &basev1.Tenant{}, nil
+}
+
+func (n *NoopTenantStore) List(_ context.Context, _ database.Pagination) ([]*basev1.Tenant, database.EncodedContinuousToken, error) {
+	return nil, database.NewEncodedContinuousToken(""), nil
+}
+
+func (n *NoopTenantStore) Delete(_ context.Context, _ uint64) error {
+	return nil
+}
+
+// ApiKeyStore - Interface for managing the API keys callers authenticate with. Keys are minted in and managed
+// for the tenant carried by the context, only the digest of their secret is stored.
+type ApiKeyStore interface {
+	// Create - Store a key, the id and creation time are assigned by the store.
+	Create(ctx context.Context, key ApiKey) (created *basev1.ApiKey, err error)
+	// Get - The key with the id, ERROR_CODE_NOT_FOUND when the tenant has no such key.
+	Get(ctx context.Context, id uint64) (key ApiKey, err error)
+	// List - Keys in id order, paginated with continuous tokens.
+	List(ctx context.Context, pagination database.Pagination) (keys []*basev1.ApiKey, ct database.EncodedContinuousToken, err error)
+	// Revoke - Revoke the key, a key that is already revoked keeps the time it was revoked at.
+	Revoke(ctx context.Context, id uint64) (revoked *basev1.ApiKey, err error)
+	// Rotate - Revoke the key and store the replacement with its name and roles in one transaction. The replacement
+	// keeps the expiry of the key unless it has one of its own, revoked keys can not be rotated.
+	Rotate(ctx context.Context, id uint64, replacement ApiKey) (created *basev1.ApiKey, err error)
+	// Lookup - The key of any tenant whose secret has the digest, ERROR_CODE_NOT_FOUND when there is none.
+	Lookup(ctx context.Context, hash string) (key ApiKey, err error)
+}
+
+type NoopApiKeyStore struct{}
+
+func NewNoopApiKeyStore() ApiKeyStore {
+	return &NoopApiKeyStore{}
+}
+
+func (n *NoopApiKeyStore) Create(_ context.Context, _ ApiKey) (*basev1.ApiKey, error) {
+	return &basev1.ApiKey{}, nil
+}
+
+func (n *NoopApiKeyStore) Get(_ context.Context, _ uint64) (ApiKey, error) {
+	return ApiKey{}, apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND)
+}
+
+func (n *NoopApiKeyStore) List(_ context.Context, _ database.Pagination) ([]*basev1.ApiKey, database.EncodedContinuousToken, error) {
+	return nil, database.NewEncodedContinuousToken(""), nil
+}
+
+func (n *NoopApiKeyStore) Revoke(_ context.Context, _ uint64) (*basev1.ApiKey, error) {
+	return &basev1.ApiKey{}, nil
+}
+
+func (n *NoopApiKeyStore) Rotate(_ context.Context, _ uint64, _ ApiKey) (*basev1.ApiKey, error) {
+	return &basev1.ApiKey{}, nil
+}
+
+func (n *NoopApiKeyStore) Lookup(_ context.Context, _ string) (ApiKey, error) {
+	return ApiKey{}, apierrors.New(basev1.ErrorCode_ERROR_CODE_NOT_FOUND)
+}
+
+// IdempotencyStore - Interface for persisting idempotency keys and the responses of the requests they were used for.
+type IdempotencyStore interface {
+	// Reserve - Claim the key for a request. When the key is already claimed and has not expired the
+	// existing record is returned and nothing is written.
+	Reserve(ctx context.Context, record IdempotencyRecord) (existing *IdempotencyRecord, err error)
+	// Complete - Store the response of the request the key was reserved for.
+	Complete(ctx context.Context, key string, response []byte) (err error)
+	// Release - Remove the reservation of a failed request so it can be retried.
+	Release(ctx context.Context, key string) (err error)
+	// DeleteExpired - Remove the keys that expired before the given time.
+	DeleteExpired(ctx context.Context, before time.Time) (deleted int64, err error)
+}
+
+type NoopIdempotencyStore struct{}
+
+func NewNoopIdempotencyStore() IdempotencyStore {
+	return &NoopIdempotencyStore{}
+}
+
+func (n *NoopIdempotencyStore) Reserve(_ context.Context, _ IdempotencyRecord) (*IdempotencyRecord, error) {
+	return nil, nil
+}
+
+func (n *NoopIdempotencyStore) Complete(_ context.Context, _ string, _ []byte) error {
+	return nil
+}
+
+func (n *NoopIdempotencyStore) Release(_ context.Context, _ string) error {
 	return nil
 }
+
+func (n *NoopIdempotencyStore) DeleteExpired(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+// AuditStore - Interface for persisting the audit trail of the calls that changed data. Events are recorded in
+// and listed for the tenant carried by the context.
+type AuditStore interface {
+	// Write - Append an audit event, the id and creation time are assigned by the store.
+	Write(ctx context.Context, event AuditEvent) (err error)
+	// List - Audit events matching the filter, latest first, paginated with continuous tokens.
+	List(ctx context.Context, filter AuditFilter, pagination database.Pagination) (events []AuditEvent, ct database.EncodedContinuousToken, err error)
+}
+
+type NoopAuditStore struct{}
+
+func NewNoopAuditStore() AuditStore {
+	return &NoopAuditStore{}
+}
+
+func (n *NoopAuditStore) Write(_ context.Context, _ AuditEvent) error {
+	return nil
+}
+
+func (n *NoopAuditStore) List(_ context.Context, _ AuditFilter, _ database.Pagination) ([]AuditEvent, database.EncodedContinuousToken, error) {
+	return nil, database.NewEncodedContinuousToken(""), nil
+}
@@ -0,0 +1,55 @@
+package tenancy
+
+import (
+	"context"
+)
+
+// DefaultTenant - Id of the tenant created by the initial setup, data written without a tenant belongs to it
+const DefaultTenant uint64 = 1
+
+type tenantKey struct{}
+
+// NewContext - Returns a copy of the context carrying the tenant id
+func NewContext(ctx context.Context, tenantID uint64) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantID)
+}
+
+// FromContext - Returns the tenant id stored in the context, if any
+func FromContext(ctx context.Context) (uint64, bool) {
+	tenantID, ok := ctx.Value(tenantKey{}).(uint64)
+	return tenantID, ok
+}
+
+// ID - Returns the tenant id stored in the context, DefaultTenant when none was resolved
+func ID(ctx context.Context) uint64 {
+	if tenantID, ok := FromContext(ctx); ok {
+		return tenantID
+	}
+	return DefaultTenant
+}
+
+type unscopedKey struct{}
+
+// Unscoped - Returns a copy of the context acting across all tenants, for maintenance not made on behalf of a tenant
+func Unscoped(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unscopedKey{}, true)
+}
+
+// IsUnscoped - Reports whether the context acts across all tenants
+func IsUnscoped(ctx context.Context) bool {
+	unscoped, _ := ctx.Value(unscopedKey{}).(bool)
+	return unscoped
+}
+
+type operatorKey struct{}
+
+// Operator - Returns a copy of the context of a caller allowed to act on behalf of every tenant, e.g. to manage them
+func Operator(ctx context.Context) context.Context {
+	return context.WithValue(ctx, operatorKey{}, true)
+}
+
+// IsOperator - Reports whether the caller of the context may act on behalf of every tenant, unscoped contexts may
+func IsOperator(ctx context.Context) bool {
+	operator, _ := ctx.Value(operatorKey{}).(bool)
+	return operator || IsUnscoped(ctx)
+}
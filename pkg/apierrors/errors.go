@@ -0,0 +1,197 @@
+package apierrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+// Error - Typed error carrying a stable ErrorCode, a human readable message and metadata.
+// Error() always returns the code name so callers can keep comparing against it, the
+// message and metadata travel to clients as an ErrorResponse status detail.
+type Error struct {
+	Code     base.ErrorCode
+	Message  string
+	Metadata map[string]string
+	cause    error
+}
+
+// New - Creates a new error with the given code
+func New(code base.ErrorCode) *Error {
+	return &Error{Code: code}
+}
+
+// Newf - Creates a new error with the given code and a formatted message
+func Newf(code base.ErrorCode, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap - Creates a new error with the given code keeping err as its cause
+func Wrap(code base.ErrorCode, err error) *Error {
+	return &Error{Code: code, cause: err}
+}
+
+// WithMessage - Sets the human readable message
+func (e *Error) WithMessage(message string) *Error {
+	e.Message = message
+	return e
+}
+
+// WithMetadata - Adds a metadata entry
+func (e *Error) WithMetadata(key, value string) *Error {
+	if e.Metadata == nil {
+		e.Metadata = map[string]string{}
+	}
+	e.Metadata[key] = value
+	return e
+}
+
+// Error - Returns the code name
+func (e *Error) Error() string {
+	return e.Code.String()
+}
+
+// Unwrap - Returns the cause
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is - Errors with the same code are considered equal
+func (e *Error) Is(target error) bool {
+	var t *Error
+	if errors.As(target, &t) {
+		return t.Code == e.Code
+	}
+	return false
+}
+
+// GRPCStatus - Converts the error to a gRPC status with an ErrorResponse detail,
+// grpc uses this to build the status sent to clients.
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(GRPCCode(e.Code), e.Error())
+	detailed, err := st.WithDetails(e.Response())
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// Response - Returns the error as the ErrorResponse message sent to clients
+func (e *Error) Response() *base.ErrorResponse {
+	return &base.ErrorResponse{
+		Code:    e.Code,
+		Message: e.Message,
+		Details: e.Metadata,
+	}
+}
+
+// FromError - Converts any error into a typed error. Errors created with errors.New(code.String())
+// keep their code, context errors become ERROR_CODE_CANCELLED and everything else ERROR_CODE_INTERNAL
+// so internal details never reach clients.
+func FromError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+
+	if code, ok := base.ErrorCode_value[err.Error()]; ok {
+		return Wrap(base.ErrorCode(code), err)
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return Wrap(base.ErrorCode_ERROR_CODE_CANCELLED, err)
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return FromStatus(st)
+	}
+
+	return Wrap(base.ErrorCode_ERROR_CODE_INTERNAL, err)
+}
+
+// FromStatus - Extracts the typed error from a gRPC status, statuses without an ErrorResponse
+// detail (e.g. produced by interceptors) are mapped from their gRPC code.
+func FromStatus(st *status.Status) *Error {
+	for _, detail := range st.Details() {
+		if resp, ok := detail.(*base.ErrorResponse); ok {
+			return &Error{Code: resp.GetCode(), Message: resp.GetMessage(), Metadata: resp.GetDetails()}
+		}
+	}
+
+	if code, ok := base.ErrorCode_value[st.Message()]; ok {
+		return New(base.ErrorCode(code))
+	}
+
+	var code base.ErrorCode
+	switch st.Code() {
+	case codes.Unauthenticated:
+		code = base.ErrorCode_ERROR_CODE_UNAUTHENTICATED
+	case codes.PermissionDenied:
+		code = base.ErrorCode_ERROR_CODE_PERMISSION_DENIED
+	case codes.InvalidArgument:
+		code = base.ErrorCode_ERROR_CODE_VALIDATION
+	case codes.NotFound:
+		code = base.ErrorCode_ERROR_CODE_NOT_FOUND
+	case codes.AlreadyExists:
+		code = base.ErrorCode_ERROR_CODE_ALREADY_EXIST
+	case codes.Canceled, codes.DeadlineExceeded:
+		code = base.ErrorCode_ERROR_CODE_CANCELLED
+	case codes.Unimplemented:
+		code = base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED
+	case codes.Unavailable:
+		code = base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER
+	case codes.ResourceExhausted:
+		code = base.ErrorCode_ERROR_CODE_RESOURCE_EXHAUSTED
+	case codes.Aborted:
+		code = base.ErrorCode_ERROR_CODE_CONFLICT
+	case codes.OutOfRange:
+		code = base.ErrorCode_ERROR_CODE_RESUME_TOKEN_EXPIRED
+	default:
+		code = base.ErrorCode_ERROR_CODE_INTERNAL
+	}
+	return &Error{Code: code, Message: st.Message()}
+}
+
+// GRPCCode - Maps an ErrorCode to its gRPC code, codes are grouped by their thousands
+func GRPCCode(code base.ErrorCode) codes.Code {
+	switch code {
+	case base.ErrorCode_ERROR_CODE_INVALID_KEY:
+		return codes.Unauthenticated
+	case base.ErrorCode_ERROR_CODE_PERMISSION_DENIED:
+		return codes.PermissionDenied
+	case base.ErrorCode_ERROR_CODE_ALREADY_EXIST, base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT:
+		return codes.AlreadyExists
+	case base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_IN_USE, base.ErrorCode_ERROR_CODE_CONFLICT:
+		return codes.Aborted
+	case base.ErrorCode_ERROR_CODE_RESUME_TOKEN_EXPIRED:
+		return codes.OutOfRange
+	case base.ErrorCode_ERROR_CODE_CANCELLED:
+		return codes.Canceled
+	case base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED:
+		return codes.Unimplemented
+	case base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER:
+		return codes.Unavailable
+	}
+
+	switch {
+	case code > 999 && code < 1999:
+		return codes.Unauthenticated
+	case code > 1999 && code < 2999:
+		return codes.InvalidArgument
+	case code > 2999 && code < 3999:
+		return codes.ResourceExhausted
+	case code > 3999 && code < 4999:
+		return codes.NotFound
+	default:
+		return codes.Internal
+	}
+}
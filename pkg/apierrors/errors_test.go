@@ -0,0 +1,65 @@
+package apierrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	base "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
+)
+
+func TestError(t *testing.T) {
+	err := Newf(base.ErrorCode_ERROR_CODE_NOT_FOUND, "user %d not found", 7).WithMetadata("id", "7")
+
+	// the code name stays the error string
+	assert.Equal(t, "ERROR_CODE_NOT_FOUND", err.Error())
+	assert.ErrorIs(t, fmt.Errorf("read: %w", err), New(base.ErrorCode_ERROR_CODE_NOT_FOUND))
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "ERROR_CODE_NOT_FOUND", st.Message())
+
+	// the detail survives the round trip
+	got := FromStatus(st)
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_NOT_FOUND, got.Code)
+	assert.Equal(t, "user 7 not found", got.Message)
+	assert.Equal(t, map[string]string{"id": "7"}, got.Metadata)
+}
+
+func TestFromError(t *testing.T) {
+	assert.Nil(t, FromError(nil))
+
+	// legacy errors carrying the code name
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_INVALID_KEY, FromError(errors.New("ERROR_CODE_INVALID_KEY")).Code)
+
+	// internal details never become the code
+	err := FromError(errors.New("failed to scan row: connection reset"))
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_INTERNAL, err.Code)
+	assert.Empty(t, err.Message)
+
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_CANCELLED, FromError(fmt.Errorf("query: %w", context.Canceled)).Code)
+
+	// statuses without detail are mapped from their grpc code
+	err = FromError(status.Error(codes.InvalidArgument, "invalid UserCreateRequest.Name"))
+	assert.Equal(t, base.ErrorCode_ERROR_CODE_VALIDATION, err.Code)
+	assert.Equal(t, "invalid UserCreateRequest.Name", err.Message)
+}
+
+func TestGRPCCode(t *testing.T) {
+	assert.Equal(t, codes.Unauthenticated, GRPCCode(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN))
+	assert.Equal(t, codes.Unauthenticated, GRPCCode(base.ErrorCode_ERROR_CODE_INVALID_KEY))
+	assert.Equal(t, codes.PermissionDenied, GRPCCode(base.ErrorCode_ERROR_CODE_PERMISSION_DENIED))
+	assert.Equal(t, codes.InvalidArgument, GRPCCode(base.ErrorCode_ERROR_CODE_VALIDATION))
+	assert.Equal(t, codes.AlreadyExists, GRPCCode(base.ErrorCode_ERROR_CODE_ALREADY_EXIST))
+	assert.Equal(t, codes.NotFound, GRPCCode(base.ErrorCode_ERROR_CODE_NOT_FOUND))
+	assert.Equal(t, codes.Unavailable, GRPCCode(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER))
+	assert.Equal(t, codes.Aborted, GRPCCode(base.ErrorCode_ERROR_CODE_CONFLICT))
+	assert.Equal(t, codes.OutOfRange, GRPCCode(base.ErrorCode_ERROR_CODE_RESUME_TOKEN_EXPIRED))
+	assert.Equal(t, codes.Internal, GRPCCode(base.ErrorCode_ERROR_CODE_SQL_BUILDER))
+}
@@ -0,0 +1,26 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// RegisterMigrateFlags - Define and registers migrate CLI flags
+func RegisterMigrateFlags(flags *pflag.FlagSet) {
+	var err error
+
+	// DATABASE
+	if err = viper.BindPFlag("database.engine", flags.Lookup("database-engine")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.engine", "SKELETON_DATABASE_ENGINE"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("database.uri", flags.Lookup("database-uri")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.uri", "SKELETON_DATABASE_URI"); err != nil {
+		panic(err)
+	}
+}
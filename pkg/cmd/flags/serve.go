@@ -22,6 +22,48 @@ func RegisterServeFlags(flags *pflag.FlagSet) {
 		panic(err)
 	}
 
+	if err = viper.BindPFlag("server.client_rate_limit.enabled", flags.Lookup("server-client-rate-limit-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.client_rate_limit.enabled", "SKELETON_CLIENT_RATE_LIMIT_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("server.client_rate_limit.key", flags.Lookup("server-client-rate-limit-key")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.client_rate_limit.key", "SKELETON_CLIENT_RATE_LIMIT_KEY"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("server.client_rate_limit.rate", flags.Lookup("server-client-rate-limit-rate")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.client_rate_limit.rate", "SKELETON_CLIENT_RATE_LIMIT_RATE"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("server.client_rate_limit.burst", flags.Lookup("server-client-rate-limit-burst")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.client_rate_limit.burst", "SKELETON_CLIENT_RATE_LIMIT_BURST"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("server.client_rate_limit.max_clients", flags.Lookup("server-client-rate-limit-max-clients")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.client_rate_limit.max_clients", "SKELETON_CLIENT_RATE_LIMIT_MAX_CLIENTS"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("server.client_rate_limit.trusted_proxies", flags.Lookup("server-client-rate-limit-trusted-proxies")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.client_rate_limit.trusted_proxies", "SKELETON_CLIENT_RATE_LIMIT_TRUSTED_PROXIES"); err != nil {
+		panic(err)
+	}
+
 	// GRPC Server
 	if err = viper.BindPFlag("server.grpc.port", flags.Lookup("grpc-port")); err != nil {
 		panic(err)
@@ -131,123 +173,125 @@ func RegisterServeFlags(flags *pflag.FlagSet) {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("logger.enabled", flags.Lookup("log-enabled")); err != nil {
+	// AUTHN
+	if err = viper.BindPFlag("authn.enabled", flags.Lookup("authn-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("logger.enabled", "SKELETON_LOG_ENABLED"); err != nil {
+	if err = viper.BindEnv("authn.enabled", "SKELETON_AUTHN_ENABLED"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("logger.exporter", flags.Lookup("log-exporter")); err != nil {
+	if err = viper.BindPFlag("authn.method", flags.Lookup("authn-method")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("logger.exporter", "SKELETON_LOG_EXPORTER"); err != nil {
+	if err = viper.BindEnv("authn.method", "SKELETON_AUTHN_METHOD"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("logger.endpoint", flags.Lookup("log-endpoint")); err != nil {
+	if err = viper.BindPFlag("authn.preshared.raw_keys", flags.Lookup("authn-preshared-keys")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("logger.endpoint", "SKELETON_LOG_ENDPOINT"); err != nil {
+	if err = viper.BindEnv("authn.preshared.raw_keys", "SKELETON_AUTHN_PRESHARED_KEYS"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("logger.insecure", flags.Lookup("log-insecure")); err != nil {
+	if err = viper.BindPFlag("authn.oidc.issuer", flags.Lookup("authn-oidc-issuer")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("logger.insecure", "SKELETON_LOG_INSECURE"); err != nil {
+	if err = viper.BindEnv("authn.oidc.issuer", "SKELETON_AUTHN_OIDC_ISSUER"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("logger.urlpath", flags.Lookup("log-urlpath")); err != nil {
+	if err = viper.BindPFlag("authn.oidc.audience", flags.Lookup("authn-oidc-audience")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("logger.urlpath", "SKELETON_LOG_URL_PATH"); err != nil {
+	if err = viper.BindEnv("authn.oidc.audience", "SKELETON_AUTHN_OIDC_AUDIENCE"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("logger.headers", flags.Lookup("log-headers")); err != nil {
+	if err = viper.BindPFlag("authn.oidc.jwks_file", flags.Lookup("authn-oidc-jwks-file")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("logger.headers", "SKELETON_LOG_HEADERS"); err != nil {
+	if err = viper.BindEnv("authn.oidc.jwks_file", "SKELETON_AUTHN_OIDC_JWKS_FILE"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("logger.protocol", flags.Lookup("log-protocol")); err != nil {
+	if err = viper.BindPFlag("authn.oidc.refresh_interval", flags.Lookup("authn-oidc-refresh-interval")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("logger.protocol", "SKELETON_LOG_PROTOCOL"); err != nil {
+	if err = viper.BindEnv("authn.oidc.refresh_interval", "SKELETON_AUTHN_OIDC_REFRESH_INTERVAL"); err != nil {
 		panic(err)
 	}
 
-	// AUTHN
-	if err = viper.BindPFlag("authn.enabled", flags.Lookup("authn-enabled")); err != nil {
+	if err = viper.BindPFlag("authn.oidc.backoff_interval", flags.Lookup("authn-oidc-backoff-interval")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.enabled", "SKELETON_AUTHN_ENABLED"); err != nil {
+	if err = viper.BindEnv("authn.oidc.backoff_interval", "SKELETON_AUTHN_OIDC_BACKOFF_INTERVAL"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("authn.method", flags.Lookup("authn-method")); err != nil {
+	if err = viper.BindPFlag("authn.oidc.backoff_frequency", flags.Lookup("authn-oidc-backoff-frequency")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.method", "SKELETON_AUTHN_METHOD"); err != nil {
+	if err = viper.BindEnv("authn.oidc.backoff_frequency", "SKELETON_AUTHN_OIDC_BACKOFF_FREQUENCY"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("authn.preshared.keys", flags.Lookup("authn-preshared-keys")); err != nil {
+	if err = viper.BindPFlag("authn.oidc.backoff_max_retries", flags.Lookup("authn-oidc-backoff-max-retries")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.preshared.keys", "SKELETON_AUTHN_PRESHARED_KEYS"); err != nil {
+	// SKELETON_AUTHN_OIDC_BACKOFF_RETRIES is the deprecated name of the variable.
+	if err = viper.BindEnv("authn.oidc.backoff_max_retries", "SKELETON_AUTHN_OIDC_BACKOFF_MAX_RETRIES", "SKELETON_AUTHN_OIDC_BACKOFF_RETRIES"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("authn.oidc.issuer", flags.Lookup("authn-oidc-issuer")); err != nil {
+	if err = viper.BindPFlag("authn.oidc.leeway", flags.Lookup("authn-oidc-leeway")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.oidc.issuer", "SKELETON_AUTHN_OIDC_ISSUER"); err != nil {
+	if err = viper.BindEnv("authn.oidc.leeway", "SKELETON_AUTHN_OIDC_LEEWAY"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("authn.oidc.audience", flags.Lookup("authn-oidc-audience")); err != nil {
+	if err = viper.BindPFlag("authn.oidc.valid_methods", flags.Lookup("authn-oidc-valid-methods")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.oidc.audience", "SKELETON_AUTHN_OIDC_AUDIENCE"); err != nil {
+	if err = viper.BindEnv("authn.oidc.valid_methods", "SKELETON_AUTHN_OIDC_VALID_METHODS"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("authn.oidc.refresh_interval", flags.Lookup("authn-oidc-refresh-interval")); err != nil {
+	if err = viper.BindPFlag("authn.oidc.roles_claim", flags.Lookup("authn-oidc-roles-claim")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.oidc.refresh_interval", "SKELETON_AUTHN_OIDC_REFRESH_INTERVAL"); err != nil {
+	if err = viper.BindEnv("authn.oidc.roles_claim", "SKELETON_AUTHN_OIDC_ROLES_CLAIM"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("authn.oidc.backoff_interval", flags.Lookup("authn-oidc-backoff-interval")); err != nil {
+	if err = viper.BindPFlag("authn.apikey.cache_ttl", flags.Lookup("authn-apikey-cache-ttl")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.oidc.backoff_interval", "SKELETON_AUTHN_OIDC_BACKOFF_INTERVAL"); err != nil {
+	if err = viper.BindEnv("authn.apikey.cache_ttl", "SKELETON_AUTHN_APIKEY_CACHE_TTL"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("authn.oidc.backoff_max_retries", flags.Lookup("authn-oidc-backoff-max-retries")); err != nil {
+	if err = viper.BindPFlag("authn.apikey.cache_entries", flags.Lookup("authn-apikey-cache-entries")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.oidc.backoff_max_retries", "SKELETON_AUTHN_OIDC_BACKOFF_RETRIES"); err != nil {
+	if err = viper.BindEnv("authn.apikey.cache_entries", "SKELETON_AUTHN_APIKEY_CACHE_ENTRIES"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("authn.oidc.backoff_frequency", flags.Lookup("authn-oidc-backoff-frequency")); err != nil {
+	// AUTHZ
+	if err = viper.BindPFlag("authz.enabled", flags.Lookup("authz-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.oidc.backoff_frequency", "SKELETON_AUTHN_OIDC_BACKOFF_FREQUENCY"); err != nil {
+	if err = viper.BindEnv("authz.enabled", "SKELETON_AUTHZ_ENABLED"); err != nil {
 		panic(err)
 	}
 
-	if err = viper.BindPFlag("authn.oidc.valid_methods", flags.Lookup("authn-oidc-valid-methods")); err != nil {
+	if err = viper.BindPFlag("authz.policy", flags.Lookup("authz-policy")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.oidc.valid_methods", "SKELETON_AUTHN_OIDC_VALID_METHODS"); err != nil {
+	if err = viper.BindEnv("authz.policy", "SKELETON_AUTHZ_POLICY"); err != nil {
 		panic(err)
 	}
 
@@ -366,6 +410,209 @@ func RegisterServeFlags(flags *pflag.FlagSet) {
 		panic(err)
 	}
 
+	if err = viper.BindPFlag("service.audit.enabled", flags.Lookup("service-audit-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.audit.enabled", "SKELETON_SERVICE_AUDIT_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.tenancy.claim", flags.Lookup("service-tenancy-claim")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.tenancy.claim", "SKELETON_SERVICE_TENANCY_CLAIM"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.tenancy.default_tenant", flags.Lookup("service-tenancy-default-tenant")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.tenancy.default_tenant", "SKELETON_SERVICE_TENANCY_DEFAULT_TENANT"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.idempotency.enabled", flags.Lookup("service-idempotency-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.idempotency.enabled", "SKELETON_SERVICE_IDEMPOTENCY_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.idempotency.ttl", flags.Lookup("service-idempotency-ttl")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.idempotency.ttl", "SKELETON_SERVICE_IDEMPOTENCY_TTL"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.idempotency.cleanup_interval", flags.Lookup("service-idempotency-cleanup-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.idempotency.cleanup_interval", "SKELETON_SERVICE_IDEMPOTENCY_CLEANUP_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.purge.enabled", flags.Lookup("service-purge-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.purge.enabled", "SKELETON_SERVICE_PURGE_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.purge.retention", flags.Lookup("service-purge-retention")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.purge.retention", "SKELETON_SERVICE_PURGE_RETENTION"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.purge.interval", flags.Lookup("service-purge-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.purge.interval", "SKELETON_SERVICE_PURGE_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.outbox.publisher", flags.Lookup("service-outbox-publisher")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.outbox.publisher", "SKELETON_SERVICE_OUTBOX_PUBLISHER"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.outbox.interval", flags.Lookup("service-outbox-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.outbox.interval", "SKELETON_SERVICE_OUTBOX_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.outbox.batch_size", flags.Lookup("service-outbox-batch-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.outbox.batch_size", "SKELETON_SERVICE_OUTBOX_BATCH_SIZE"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.outbox.max_backoff", flags.Lookup("service-outbox-max-backoff")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.outbox.max_backoff", "SKELETON_SERVICE_OUTBOX_MAX_BACKOFF"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.outbox.retention", flags.Lookup("service-outbox-retention")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.outbox.retention", "SKELETON_SERVICE_OUTBOX_RETENTION"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.outbox.cleanup_interval", flags.Lookup("service-outbox-cleanup-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.outbox.cleanup_interval", "SKELETON_SERVICE_OUTBOX_CLEANUP_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.outbox.webhook.url", flags.Lookup("service-outbox-webhook-url")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.outbox.webhook.url", "SKELETON_SERVICE_OUTBOX_WEBHOOK_URL"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.outbox.webhook.secret", flags.Lookup("service-outbox-webhook-secret")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.outbox.webhook.secret", "SKELETON_SERVICE_OUTBOX_WEBHOOK_SECRET"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.outbox.webhook.timeout", flags.Lookup("service-outbox-webhook-timeout")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.outbox.webhook.timeout", "SKELETON_SERVICE_OUTBOX_WEBHOOK_TIMEOUT"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.cache.enabled", flags.Lookup("service-cache-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.cache.enabled", "SKELETON_SERVICE_CACHE_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.cache.num_counters", flags.Lookup("service-cache-num-counters")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.cache.num_counters", "SKELETON_SERVICE_CACHE_NUM_COUNTERS"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.cache.max_cost", flags.Lookup("service-cache-max-cost")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.cache.max_cost", "SKELETON_SERVICE_CACHE_MAX_COST"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.cache.ttl", flags.Lookup("service-cache-ttl")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.cache.ttl", "SKELETON_SERVICE_CACHE_TTL"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.breaker.min_requests", flags.Lookup("service-breaker-min-requests")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.breaker.min_requests", "SKELETON_SERVICE_BREAKER_MIN_REQUESTS"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.breaker.failure_ratio", flags.Lookup("service-breaker-failure-ratio")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.breaker.failure_ratio", "SKELETON_SERVICE_BREAKER_FAILURE_RATIO"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.breaker.interval", flags.Lookup("service-breaker-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.breaker.interval", "SKELETON_SERVICE_BREAKER_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.breaker.timeout", flags.Lookup("service-breaker-timeout")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.breaker.timeout", "SKELETON_SERVICE_BREAKER_TIMEOUT"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.breaker.max_requests", flags.Lookup("service-breaker-max-requests")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.breaker.max_requests", "SKELETON_SERVICE_BREAKER_MAX_REQUESTS"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.health.probe_interval", flags.Lookup("service-health-probe-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.health.probe_interval", "SKELETON_SERVICE_HEALTH_PROBE_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.pagination.secret", flags.Lookup("service-pagination-secret")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.pagination.secret", "SKELETON_SERVICE_PAGINATION_SECRET"); err != nil {
+		panic(err)
+	}
+
 	// DATABASE
 	if err = viper.BindPFlag("database.engine", flags.Lookup("database-engine")); err != nil {
 		panic(err)
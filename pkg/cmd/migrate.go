@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tolgaOzen/go-skeleton/internal/config"
+	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/pkg/cmd/flags"
+)
+
+// NewMigrateCommand - Creates new migrate command with its subcommands
+func NewMigrateCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "migrate",
+		Short: "migrate the database schema",
+		Args:  cobra.NoArgs,
+	}
+
+	conf := config.DefaultConfig()
+	f := command.PersistentFlags()
+	f.String("database-engine", conf.Database.Engine, "data source. e.g. postgres, memory")
+	f.String("database-uri", conf.Database.URI, "uri of your data source to migrate")
+
+	// SilenceUsage is set to true to suppress usage when an error occurs
+	command.SilenceUsage = true
+
+	command.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterMigrateFlags(f)
+	}
+
+	command.AddCommand(
+		newMigrateUpCommand(),
+		newMigrateUpToCommand(),
+		newMigrateDownCommand(),
+		newMigrateDownToCommand(),
+		newMigrateResetCommand(),
+		newMigrateStatusCommand(),
+	)
+
+	return command
+}
+
+func newMigrateUpCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:          "up",
+		Short:        "apply all available migrations",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := storage.MigrateUp(viper.GetString("database.engine"), viper.GetString("database.uri")); err != nil {
+				return fmt.Errorf("failed to migrate up: %w", err)
+			}
+			slog.Info("migration up completed")
+			return nil
+		},
+	}
+}
+
+func newMigrateUpToCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:          "up-to <version>",
+		Short:        "apply migrations up to a specific version",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, err := parseVersion(args[0])
+			if err != nil {
+				return err
+			}
+			if err = storage.MigrateUpTo(viper.GetString("database.engine"), viper.GetString("database.uri"), version); err != nil {
+				return fmt.Errorf("failed to migrate up to %d: %w", version, err)
+			}
+			slog.Info("migration up completed", slog.Int64("version", version))
+			return nil
+		},
+	}
+}
+
+func newMigrateDownCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:          "down",
+		Short:        "roll back the most recent migration",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := storage.MigrateDown(viper.GetString("database.engine"), viper.GetString("database.uri")); err != nil {
+				return fmt.Errorf("failed to migrate down: %w", err)
+			}
+			slog.Info("migration down completed")
+			return nil
+		},
+	}
+}
+
+func newMigrateDownToCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:          "down-to <version>",
+		Short:        "roll back migrations down to a specific version",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, err := parseVersion(args[0])
+			if err != nil {
+				return err
+			}
+			if err = storage.MigrateDownTo(viper.GetString("database.engine"), viper.GetString("database.uri"), version); err != nil {
+				return fmt.Errorf("failed to migrate down to %d: %w", version, err)
+			}
+			slog.Info("migration down completed", slog.Int64("version", version))
+			return nil
+		},
+	}
+}
+
+func newMigrateResetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:          "reset",
+		Short:        "roll back all migrations",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := storage.MigrateReset(viper.GetString("database.engine"), viper.GetString("database.uri")); err != nil {
+				return fmt.Errorf("failed to reset migrations: %w", err)
+			}
+			slog.Info("migration reset completed")
+			return nil
+		},
+	}
+}
+
+func newMigrateStatusCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:          "status",
+		Short:        "show the status of all migrations",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			statuses, err := storage.MigrateStatus(viper.GetString("database.engine"), viper.GetString("database.uri"))
+			if err != nil {
+				return fmt.Errorf("failed to get migration status: %w", err)
+			}
+
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case "json":
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(statuses)
+			case "table":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "VERSION\tNAME\tSTATE\tAPPLIED AT")
+				for _, s := range statuses {
+					appliedAt := "-"
+					if !s.AppliedAt.IsZero() {
+						appliedAt = s.AppliedAt.Format(time.RFC3339)
+					}
+					fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", s.Version, s.Name, s.State, appliedAt)
+				}
+				return w.Flush()
+			default:
+				return fmt.Errorf("unknown output format: '%s'", output)
+			}
+		},
+	}
+
+	command.Flags().StringP("output", "o", "table", "output format, valid values table, json")
+
+	return command
+}
+
+// parseVersion converts a migration version argument to its numeric form.
+func parseVersion(arg string) (int64, error) {
+	version, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid migration version '%s': %w", arg, err)
+	}
+	return version, nil
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -20,15 +21,21 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/tolgaOzen/go-skeleton/internal"
+	"github.com/tolgaOzen/go-skeleton/internal/authz"
 	"github.com/tolgaOzen/go-skeleton/internal/config"
 	"github.com/tolgaOzen/go-skeleton/internal/factories"
+	"github.com/tolgaOzen/go-skeleton/internal/middleware"
+	"github.com/tolgaOzen/go-skeleton/internal/publisher"
 	"github.com/tolgaOzen/go-skeleton/internal/servers"
 	"github.com/tolgaOzen/go-skeleton/internal/storage"
+	"github.com/tolgaOzen/go-skeleton/internal/storage/decorators/cache"
 	"github.com/tolgaOzen/go-skeleton/internal/storage/decorators/circuitBreaker"
 	"github.com/tolgaOzen/go-skeleton/pkg/cmd/flags"
+	grpcV1 "github.com/tolgaOzen/go-skeleton/pkg/pb/base/v1"
 	"github.com/tolgaOzen/go-skeleton/pkg/telemetry"
 	"github.com/tolgaOzen/go-skeleton/pkg/telemetry/meterexporters"
 	"github.com/tolgaOzen/go-skeleton/pkg/telemetry/tracerexporters"
+	"github.com/tolgaOzen/go-skeleton/pkg/token"
 )
 
 func NewServeCommand() *cobra.Command {
@@ -44,6 +51,12 @@ func NewServeCommand() *cobra.Command {
 	f.StringP("config", "c", "", "config file (default is $HOME/.config.yaml)")
 	f.Bool("http-enabled", conf.Server.HTTP.Enabled, "switch option for HTTP server")
 	f.Int64("server-rate-limit", conf.Server.RateLimit, "the maximum number of requests the server should handle per second")
+	f.Bool("server-client-rate-limit-enabled", conf.Server.ClientRateLimit.Enabled, "rate limit every client separately")
+	f.String("server-client-rate-limit-key", conf.Server.ClientRateLimit.Key, "identity clients are rate limited by: bearer, ip or subject")
+	f.Int64("server-client-rate-limit-rate", conf.Server.ClientRateLimit.Rate, "the maximum number of requests a client can make per second")
+	f.Int64("server-client-rate-limit-burst", conf.Server.ClientRateLimit.Burst, "the maximum number of requests a client can burst")
+	f.Int("server-client-rate-limit-max-clients", conf.Server.ClientRateLimit.MaxClients, "the number of client buckets kept in memory")
+	f.StringSlice("server-client-rate-limit-trusted-proxies", conf.Server.ClientRateLimit.TrustedProxies, "addresses or CIDR ranges of the proxies whose x-forwarded-for header is honoured")
 	f.String("server-name-override", conf.Server.NameOverride, "server name override")
 	f.String("grpc-port", conf.Server.GRPC.Port, "port that GRPC server run on")
 	f.Bool("grpc-tls-enabled", conf.Server.GRPC.TLSConfig.Enabled, "switch option for GRPC tls server")
@@ -61,7 +74,21 @@ func NewServeCommand() *cobra.Command {
 	f.String("log-output", conf.Log.Output, "logger output valid values json, text")
 	f.Bool("authn-enabled", conf.Authn.Enabled, "enable server authentication")
 	f.String("authn-method", conf.Authn.Method, "server authentication method")
-	f.StringSlice("authn-preshared-keys", conf.Authn.Preshared.Keys, "preshared key/keys for server authentication")
+	f.StringSlice("authn-preshared-keys", conf.Authn.Preshared.RawKeys, "preshared key/keys for server authentication, hashed when loaded")
+	f.String("authn-oidc-issuer", conf.Authn.Oidc.Issuer, "issuer url of the oidc provider, the jwks is discovered from it")
+	f.String("authn-oidc-audience", conf.Authn.Oidc.Audience, "expected audience of the oidc tokens")
+	f.String("authn-oidc-jwks-file", conf.Authn.Oidc.JWKSFile, "local jwks file used instead of the issuer discovery")
+	f.Duration("authn-oidc-refresh-interval", conf.Authn.Oidc.RefreshInterval, "refresh interval of the jwks")
+	f.Duration("authn-oidc-backoff-interval", conf.Authn.Oidc.BackoffInterval, "minimum time between jwks refreshes triggered by unknown key ids")
+	f.Duration("authn-oidc-backoff-frequency", conf.Authn.Oidc.BackoffFrequency, "delay between retries of a failed jwks fetch")
+	f.Int("authn-oidc-backoff-max-retries", conf.Authn.Oidc.BackoffMaxRetries, "maximum number of retries of a failed jwks fetch")
+	f.Duration("authn-oidc-leeway", conf.Authn.Oidc.Leeway, "allowed clock skew when validating token times")
+	f.StringSlice("authn-oidc-valid-methods", conf.Authn.Oidc.ValidMethods, "accepted signing algorithms of the oidc tokens")
+	f.String("authn-oidc-roles-claim", conf.Authn.Oidc.RolesClaim, "claim of the oidc tokens holding the roles of the caller")
+	f.Duration("authn-apikey-cache-ttl", conf.Authn.ApiKey.CacheTTL, "how long looked up api keys are trusted before they are looked up again")
+	f.Int64("authn-apikey-cache-entries", conf.Authn.ApiKey.CacheEntries, "maximum number of cached api keys")
+	f.Bool("authz-enabled", conf.Authz.Enabled, "authorize calls against the permissions granted to the roles of the caller")
+	f.String("authz-policy", conf.Authz.Policy, "path of the policy file granting permissions to roles")
 	f.Bool("tracer-enabled", conf.Tracer.Enabled, "switch option for tracing")
 	f.String("tracer-exporter", conf.Tracer.Exporter, "can be; jaeger, signoz, zipkin or otlp. (integrated tracing tools)")
 	f.String("tracer-endpoint", conf.Tracer.Endpoint, "export uri for tracing data")
@@ -78,6 +105,35 @@ func NewServeCommand() *cobra.Command {
 	f.Int("meter-interval", conf.Meter.Interval, "allows to set metrics to be pushed in certain time interval")
 	f.String("meter-protocol", conf.Meter.Protocol, "allows setting the communication protocol for the meter exporter, with options http or grpc")
 	f.Bool("service-circuit-breaker", conf.Service.CircuitBreaker, "switch option for service circuit breaker")
+	f.Uint32("service-breaker-min-requests", conf.Service.Breaker.MinRequests, "minimum number of requests before the circuit breaker can trip")
+	f.Float64("service-breaker-failure-ratio", conf.Service.Breaker.FailureRatio, "ratio of failed requests that trips the circuit breaker")
+	f.Duration("service-breaker-interval", conf.Service.Breaker.Interval, "period after which the circuit breaker counts are cleared")
+	f.Duration("service-breaker-timeout", conf.Service.Breaker.Timeout, "time the circuit breaker stays open before allowing trial requests")
+	f.Uint32("service-breaker-max-requests", conf.Service.Breaker.MaxRequests, "number of trial requests allowed while the circuit breaker is half-open")
+	f.Bool("service-purge-enabled", conf.Service.Purge.Enabled, "permanently remove soft deleted users once their retention period has passed")
+	f.Duration("service-purge-retention", conf.Service.Purge.Retention, "time soft deleted users can be restored before they are purged")
+	f.Duration("service-purge-interval", conf.Service.Purge.Interval, "interval between purges of soft deleted users")
+	f.String("service-outbox-publisher", conf.Service.Outbox.Publisher, "publisher user events are relayed through, none, log or webhook")
+	f.Duration("service-outbox-interval", conf.Service.Outbox.Interval, "interval between relays of pending user events")
+	f.Int("service-outbox-batch-size", conf.Service.Outbox.BatchSize, "maximum number of user events published per relay")
+	f.Duration("service-outbox-max-backoff", conf.Service.Outbox.MaxBackoff, "longest wait between retries of a failing publish")
+	f.Duration("service-outbox-retention", conf.Service.Outbox.Retention, "time published user events are kept, watchers can resume within it")
+	f.Duration("service-outbox-cleanup-interval", conf.Service.Outbox.CleanupInterval, "interval between cleanups of published user events")
+	f.String("service-outbox-webhook-url", conf.Service.Outbox.Webhook.URL, "endpoint the webhook publisher posts user events to")
+	f.String("service-outbox-webhook-secret", conf.Service.Outbox.Webhook.Secret, "secret signing the bodies posted by the webhook publisher")
+	f.Duration("service-outbox-webhook-timeout", conf.Service.Outbox.Webhook.Timeout, "timeout of a single webhook delivery")
+	f.Bool("service-cache-enabled", conf.Service.Cache.Enabled, "cache storage reads in memory")
+	f.Int64("service-cache-num-counters", conf.Service.Cache.NumCounters, "number of keys tracked by the cache admission policy")
+	f.Int64("service-cache-max-cost", conf.Service.Cache.MaxCost, "maximum size of the cache in bytes")
+	f.Duration("service-cache-ttl", conf.Service.Cache.TTL, "lifetime of a cache entry")
+	f.Bool("service-idempotency-enabled", conf.Service.Idempotency.Enabled, "replay the response of retried mutating requests carrying an Idempotency-Key")
+	f.Duration("service-idempotency-ttl", conf.Service.Idempotency.TTL, "window in which retried requests replay the stored response")
+	f.Duration("service-idempotency-cleanup-interval", conf.Service.Idempotency.CleanupInterval, "interval between deletions of expired idempotency keys")
+	f.Bool("service-audit-enabled", conf.Service.Audit.Enabled, "record an audit event for every mutating call")
+	f.String("service-tenancy-claim", conf.Service.Tenancy.Claim, "claim of the authenticated principal naming the tenant it belongs to")
+	f.Uint64("service-tenancy-default-tenant", conf.Service.Tenancy.DefaultTenant, "tenant of calls naming none, 0 requires every call to name a tenant")
+	f.Duration("service-health-probe-interval", conf.Service.Health.ProbeInterval, "interval between datastore readiness probes of the health server")
+	f.String("service-pagination-secret", conf.Service.Pagination.Secret, "secret used to sign page tokens, a random one is generated when empty")
 	f.String("database-engine", conf.Database.Engine, "data source. e.g. postgres, memory")
 	f.String("database-uri", conf.Database.URI, "uri of your data source to store relation tuples and schema")
 	f.String("database-writer-uri", conf.Database.Writer.URI, "writer uri of your data source to store relation tuples and schema")
@@ -109,7 +165,7 @@ func serve() func(cmd *cobra.Command, args []string) error {
 				return fmt.Errorf("failed to create new config: %w", err)
 			}
 
-			if err = viper.Unmarshal(cfg); err != nil {
+			if err = config.Unmarshal(cfg); err != nil {
 				return fmt.Errorf("failed to unmarshal config: %w", err)
 			}
 		} else {
@@ -119,7 +175,7 @@ func serve() func(cmd *cobra.Command, args []string) error {
 				return fmt.Errorf("failed to create new config: %w", err)
 			}
 
-			if err = viper.Unmarshal(cfg); err != nil {
+			if err = config.Unmarshal(cfg); err != nil {
 				return fmt.Errorf("failed to unmarshal config: %w", err)
 			}
 		}
@@ -245,31 +301,145 @@ func serve() func(cmd *cobra.Command, args []string) error {
 		dataReader := factories.DataReaderFactory(db)
 		dataWriter := factories.DataWriterFactory(db)
 
+		var cb *gobreaker.CircuitBreaker
 		if cfg.Service.CircuitBreaker {
-			var cb *gobreaker.CircuitBreaker
-			var st gobreaker.Settings
-			st.Name = "storage"
-			st.ReadyToTrip = func(counts gobreaker.Counts) bool {
-				failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
-				return counts.Requests >= 10 && failureRatio >= 0.6
+			cb, err = circuitBreaker.NewCircuitBreaker("storage", cfg.Service.Breaker)
+			if err != nil {
+				return err
 			}
 
-			cb = gobreaker.NewCircuitBreaker(st)
-
-			// Wrap the dataReader with circuit breaker
+			// Wrap the dataReader and dataWriter with circuit breaker
 			dataReader = circuitBreaker.NewDataReader(dataReader, cb)
+			dataWriter = circuitBreaker.NewDataWriter(dataWriter, cb)
+		}
+
+		// The cache wraps the circuit breaker so hits are served even when the datastore is unavailable.
+		if cfg.Service.Cache.Enabled {
+			var c *cache.Cache
+			c, err = cache.New(cfg.Service.Cache)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			dataReader = cache.NewDataReader(dataReader, c)
+			dataWriter = cache.NewDataWriter(dataWriter, c)
+		}
+
+		// Page tokens are signed so clients cannot forge positions; without a configured
+		// secret they are only valid for the lifetime of this process.
+		secret := []byte(cfg.Service.Pagination.Secret)
+		if len(secret) == 0 {
+			secret = make([]byte, 32)
+			if _, err = rand.Read(secret); err != nil {
+				return err
+			}
+			slog.Warn("no pagination secret configured, page tokens will not be valid across restarts or replicas")
+		}
+
+		// The health server derives its status from the datastore and the circuit breaker
+		healthServer := servers.NewHealthServer(
+			db,
+			cb,
+			cfg.Service.Health.ProbeInterval,
+			grpcV1.UserService_ServiceDesc.ServiceName,
+		)
+
+		// Retried mutating requests carrying an Idempotency-Key replay the stored response
+		var idempotency *middleware.Idempotency
+		if cfg.Service.Idempotency.Enabled {
+			idempotency = middleware.NewIdempotency(factories.IdempotencyStoreFactory(db), cfg.Service.Idempotency.TTL)
+		}
+
+		// Every mutating call leaves an audit event, the trail can be read whether or not recording is enabled
+		auditStore := factories.AuditStoreFactory(db)
+		var audit *middleware.Audit
+		if cfg.Service.Audit.Enabled {
+			audit = middleware.NewAudit(auditStore)
+		}
+
+		// Calls are authorized against the permissions the policy file grants to the roles of the caller
+		var authorization *middleware.Authorization
+		var authorizer *authz.Authorizer
+		if cfg.Authz.Enabled {
+			if !cfg.Authn.Enabled {
+				return errors.New("authorization requires authentication to be enabled")
+			}
+			authorizer, err = authz.LoadPolicy(cfg.Authz.Policy)
+			if err != nil {
+				return err
+			}
+			authorization = middleware.NewAuthorization(authorizer)
 		}
 
+		// Calls are scoped to the tenant named by the credentials or the X-Tenant-Id header
+		tenantStore := factories.TenantStoreFactory(db)
+
 		// Initialize the container which brings together multiple components such as the invoker, data readers/writers, and schema handlers.
 		container := servers.NewContainer(
 			dataReader,
 			dataWriter,
+			factories.WatcherFactory(db),
+			auditStore,
+			tenantStore,
+			factories.ApiKeyStoreFactory(db),
+			token.NewSigner(secret),
+			healthServer,
+			idempotency,
+			audit,
+			middleware.NewTenancy(cfg.Service.Tenancy, authorizer),
+			authorization,
 		)
 
+		// Soft deleted users are purged through the decorated writer so cached reads are invalidated
+		var purger *storage.Purger
+		if cfg.Service.Purge.Enabled {
+			purger, err = storage.NewPurger(dataWriter, cfg.Service.Purge.Retention)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Relay the user events recorded by every write and clean up the delivered ones
+		var eventPublisher publisher.EventPublisher
+		eventPublisher, err = factories.EventPublisherFactory(cfg.Service.Outbox)
+		if err != nil {
+			return err
+		}
+		var relay *publisher.Relay
+		relay, err = publisher.NewRelay(factories.OutboxFactory(db), eventPublisher, cfg.Service.Outbox.BatchSize, cfg.Service.Outbox.Retention)
+		if err != nil {
+			return err
+		}
+
 		// Create an error group with the provided context
 		var g *errgroup.Group
 		g, ctx = errgroup.WithContext(ctx)
 
+		// Probe the datastore in the background for the lifetime of the server
+		g.Go(func() error {
+			return healthServer.Run(ctx)
+		})
+
+		// Delete expired idempotency keys in the background
+		if idempotency != nil {
+			g.Go(func() error {
+				return idempotency.Run(ctx, cfg.Service.Idempotency.CleanupInterval)
+			})
+		}
+
+		// Purge soft deleted users past their retention period in the background
+		if purger != nil {
+			g.Go(func() error {
+				return purger.Run(ctx, cfg.Service.Purge.Interval)
+			})
+		}
+
+		// Publish user events in the background
+		g.Go(func() error {
+			return relay.Run(ctx, cfg.Service.Outbox.Interval, cfg.Service.Outbox.MaxBackoff, cfg.Service.Outbox.CleanupInterval)
+		})
+
 		// Add the container.Run function to the error group
 		g.Go(func() error {
 			return container.Run(
@@ -3,6 +3,7 @@ package memory
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/hashicorp/go-memdb"
 )
@@ -12,6 +13,9 @@ type Memory struct {
 	sync.RWMutex
 	rid uint64
 	aid uint64
+	eid uint64
+	tid uint64
+	kid uint64
 
 	DB *memdb.MemDB
 }
@@ -41,3 +45,28 @@ func (m *Memory) Close() error {
 func (m *Memory) IsReady(_ context.Context) (bool, error) {
 	return true, nil
 }
+
+// NextRID - Increments the record id counter and returns the new value
+func (m *Memory) NextRID() uint64 {
+	return atomic.AddUint64(&m.rid, 1)
+}
+
+// NextEID - Increments the event id counter and returns the new value
+func (m *Memory) NextEID() uint64 {
+	return atomic.AddUint64(&m.eid, 1)
+}
+
+// NextAID - Increments the audit id counter and returns the new value
+func (m *Memory) NextAID() uint64 {
+	return atomic.AddUint64(&m.aid, 1)
+}
+
+// NextTID - Increments the tenant id counter and returns the new value
+func (m *Memory) NextTID() uint64 {
+	return atomic.AddUint64(&m.tid, 1)
+}
+
+// NextKID - Increments the api key id counter and returns the new value
+func (m *Memory) NextKID() uint64 {
+	return atomic.AddUint64(&m.kid, 1)
+}
@@ -0,0 +1,46 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Order - Field and direction rows are sorted by
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+// ParseOrder - Parses "<field> [asc|desc]", the field must be one of the allowed fields
+func ParseOrder(value string, allowed ...string) (Order, error) {
+	parts := strings.Fields(value)
+	if len(parts) == 0 || len(parts) > 2 {
+		return Order{}, fmt.Errorf("invalid order '%s'", value)
+	}
+
+	order := Order{Field: parts[0]}
+	if len(parts) == 2 {
+		switch strings.ToLower(parts[1]) {
+		case "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return Order{}, fmt.Errorf("invalid order direction '%s'", parts[1])
+		}
+	}
+
+	for _, a := range allowed {
+		if a == order.Field {
+			return order, nil
+		}
+	}
+	return Order{}, fmt.Errorf("field '%s' can not be ordered by", order.Field)
+}
+
+// String - Returns the order as "<field> <asc|desc>"
+func (o Order) String() string {
+	if o.Desc {
+		return o.Field + " desc"
+	}
+	return o.Field + " asc"
+}
@@ -10,17 +10,33 @@ func Size(size uint32) PaginationOption {
 	}
 }
 
-// Page -
+// Page - Offset based page number, only used when no Token is given
 func Page(page uint32) PaginationOption {
 	return func(c *Pagination) {
 		c.page = page
 	}
 }
 
+// Token - Continuous token of the page to start reading from
+func Token(token string) PaginationOption {
+	return func(c *Pagination) {
+		c.token = token
+	}
+}
+
+// OrderBy - Sort order of the paginated rows, ties are broken by id in the same direction
+func OrderBy(order Order) PaginationOption {
+	return func(c *Pagination) {
+		c.order = order
+	}
+}
+
 // Pagination -
 type Pagination struct {
-	size uint32
-	page uint32
+	size  uint32
+	page  uint32
+	token string
+	order Order
 }
 
 // NewPagination -
@@ -40,7 +56,67 @@ func (p Pagination) Size() uint32 {
 	return p.size
 }
 
+// PageSize -
+func (p Pagination) PageSize() uint32 {
+	return p.size
+}
+
 // Page -
 func (p Pagination) Page() uint32 {
 	return p.page
 }
+
+// Token -
+func (p Pagination) Token() string {
+	return p.token
+}
+
+// Order - Sort order, the zero value leaves the order to the storage
+func (p Pagination) Order() Order {
+	return p.order
+}
+
+// CursorPaginationOption - Option type
+type CursorPaginationOption func(*CursorPagination)
+
+// Cursor -
+func Cursor(cursor string) CursorPaginationOption {
+	return func(c *CursorPagination) {
+		c.cursor = cursor
+	}
+}
+
+// Sort -
+func Sort(sort string) CursorPaginationOption {
+	return func(c *CursorPagination) {
+		c.sort = sort
+	}
+}
+
+// CursorPagination -
+type CursorPagination struct {
+	cursor string
+	sort   string
+}
+
+// NewCursorPagination -
+func NewCursorPagination(opts ...CursorPaginationOption) CursorPagination {
+	pagination := &CursorPagination{}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(pagination)
+	}
+
+	return *pagination
+}
+
+// Cursor -
+func (p CursorPagination) Cursor() string {
+	return p.cursor
+}
+
+// Sort -
+func (p CursorPagination) Sort() string {
+	return p.sort
+}
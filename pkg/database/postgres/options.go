@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"time"
 )
 
@@ -34,3 +35,11 @@ func MaxConnectionLifeTime(d time.Duration) Option {
 		p.maxConnectionLifeTime = d
 	}
 }
+
+// SessionVariable - Sets the session variable on every connection acquired from the pools to the value returned
+// for the context of the acquiring query, an empty value resets it
+func SessionVariable(name string, value func(ctx context.Context) string) Option {
+	return func(p *Postgres) {
+		p.sessionVariables = append(p.sessionVariables, sessionVariable{name: name, value: value})
+	}
+}
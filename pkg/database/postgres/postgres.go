@@ -29,6 +29,13 @@ type Postgres struct {
 	maxConnectionIdleTime time.Duration
 	maxOpenConnections    int
 	maxIdleConnections    int
+	sessionVariables      []sessionVariable
+}
+
+// sessionVariable - Session variable set on every acquired connection
+type sessionVariable struct {
+	name  string
+	value func(ctx context.Context) string
 }
 
 // New -
@@ -93,6 +100,12 @@ func newDB(writerUri, readerUri string, opts ...Option) (*Postgres, error) {
 	writeConfig.MaxConnLifetimeJitter = time.Duration(0.2 * float64(pg.maxConnectionLifeTime))
 	readConfig.MaxConnLifetimeJitter = time.Duration(0.2 * float64(pg.maxConnectionLifeTime))
 
+	// Set the session variables on every acquired connection, pooled connections keep the values of their previous use.
+	if len(pg.sessionVariables) > 0 {
+		writeConfig.PrepareConn = pg.prepareConn
+		readConfig.PrepareConn = pg.prepareConn
+	}
+
 	writeConfig.ConnConfig.Tracer = otelpgx.NewTracer()
 	readConfig.ConnConfig.Tracer = otelpgx.NewTracer()
 
@@ -132,6 +145,17 @@ func (p *Postgres) IsReady(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// prepareConn sets the session variables for the context acquiring the connection, a connection the variables
+// could not be set on is destroyed and the query fails.
+func (p *Postgres) prepareConn(ctx context.Context, conn *pgx.Conn) (bool, error) {
+	for _, v := range p.sessionVariables {
+		if _, err := conn.Exec(ctx, "SELECT set_config($1, $2, false)", v.name, v.value(ctx)); err != nil {
+			return false, fmt.Errorf("failed to set session variable %s: %w", v.name, err)
+		}
+	}
+	return true, nil
+}
+
 var queryExecModes = map[string]pgx.QueryExecMode{
 	"cache_statement": pgx.QueryExecModeCacheStatement,
 	"cache_describe":  pgx.QueryExecModeCacheDescribe,
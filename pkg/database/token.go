@@ -0,0 +1,68 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// ContinuousToken - Position of the last row returned by a keyset paginated query
+type ContinuousToken struct {
+	Order string `json:"o"`           // order the token was issued for, e.g. "created_at desc"
+	Value string `json:"v,omitempty"` // value of the ordered field in the last row, empty when ordered by id
+	ID    uint64 `json:"i"`           // id of the last row, breaks ties of the ordered field
+}
+
+// NewContinuousToken - Creates new continuous token
+func NewContinuousToken(order Order, value string, id uint64) ContinuousToken {
+	return ContinuousToken{
+		Order: order.String(),
+		Value: value,
+		ID:    id,
+	}
+}
+
+// Encode - Encodes the token to an opaque string
+func (t ContinuousToken) Encode() EncodedContinuousToken {
+	b, _ := json.Marshal(t)
+	return EncodedContinuousToken{
+		Value: base64.RawURLEncoding.EncodeToString(b),
+	}
+}
+
+// EncodedContinuousToken - Opaque form of the continuous token
+type EncodedContinuousToken struct {
+	Value string
+}
+
+// NewEncodedContinuousToken - Creates new encoded continuous token
+func NewEncodedContinuousToken(value string) EncodedContinuousToken {
+	return EncodedContinuousToken{
+		Value: value,
+	}
+}
+
+// String - Returns the opaque string form of the token, empty when there are no more pages
+func (t EncodedContinuousToken) String() string {
+	return t.Value
+}
+
+// Decode - Decodes the opaque string back to the continuous token, the token must have been
+// issued for the given order since its position is meaningless in any other.
+func (t EncodedContinuousToken) Decode(order Order) (ContinuousToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(t.Value)
+	if err != nil {
+		return ContinuousToken{}, err
+	}
+	var ct ContinuousToken
+	if err = json.Unmarshal(b, &ct); err != nil {
+		return ContinuousToken{}, err
+	}
+	if ct.ID == 0 {
+		return ContinuousToken{}, errors.New("continuous token is incomplete")
+	}
+	if ct.Order != order.String() {
+		return ContinuousToken{}, errors.New("continuous token was issued for another order")
+	}
+	return ct, nil
+}
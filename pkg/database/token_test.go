@@ -0,0 +1,49 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContinuousToken(t *testing.T) {
+	order := Order{Field: "created_at", Desc: true}
+
+	encoded := NewContinuousToken(order, "2025-02-18T11:36:33.123456Z", 42).Encode()
+	assert.NotEmpty(t, encoded.String())
+
+	decoded, err := NewEncodedContinuousToken(encoded.String()).Decode(order)
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-02-18T11:36:33.123456Z", decoded.Value)
+	assert.Equal(t, uint64(42), decoded.ID)
+
+	// tokens are bound to the order they were issued for
+	_, err = NewEncodedContinuousToken(encoded.String()).Decode(Order{Field: "created_at"})
+	assert.Error(t, err)
+
+	_, err = NewEncodedContinuousToken("not-a-token").Decode(order)
+	assert.Error(t, err)
+
+	_, err = NewEncodedContinuousToken(ContinuousToken{}.Encode().String()).Decode(order)
+	assert.Error(t, err)
+}
+
+func TestParseOrder(t *testing.T) {
+	order, err := ParseOrder("created_at desc", "id", "created_at")
+	assert.NoError(t, err)
+	assert.Equal(t, Order{Field: "created_at", Desc: true}, order)
+	assert.Equal(t, "created_at desc", order.String())
+
+	order, err = ParseOrder("id", "id", "created_at")
+	assert.NoError(t, err)
+	assert.Equal(t, Order{Field: "id"}, order)
+
+	_, err = ParseOrder("password desc", "id", "created_at")
+	assert.Error(t, err)
+
+	_, err = ParseOrder("id sideways", "id", "created_at")
+	assert.Error(t, err)
+
+	_, err = ParseOrder("", "id")
+	assert.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package etag
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidETag is returned when an If-Match value is not an entity tag issued by Format.
+var ErrInvalidETag = errors.New("invalid entity tag")
+
+// Any is the If-Match value matching every revision.
+const Any = "*"
+
+// Format - Returns the strong entity tag of the revision, e.g. "3"
+func Format(revision uint64) string {
+	return strconv.Quote(strconv.FormatUint(revision, 10))
+}
+
+// Parse - Returns the revision of an entity tag, "*" yields 0 which matches every revision.
+// Weak tags are accepted since revisions are compared exactly anyway.
+func Parse(value string) (uint64, error) {
+	value = strings.TrimSpace(value)
+	if value == Any {
+		return 0, nil
+	}
+	value = strings.TrimPrefix(value, "W/")
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return 0, ErrInvalidETag
+	}
+	revision, err := strconv.ParseUint(value[1:len(value)-1], 10, 64)
+	if err != nil || revision == 0 {
+		return 0, ErrInvalidETag
+	}
+	return revision, nil
+}
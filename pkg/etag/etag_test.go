@@ -0,0 +1,28 @@
+package etag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestETag(t *testing.T) {
+	assert.Equal(t, `"3"`, Format(3))
+
+	revision, err := Parse(Format(42))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(42), revision)
+
+	revision, err = Parse(` W/"7" `)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), revision)
+
+	revision, err = Parse(Any)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), revision)
+
+	for _, invalid := range []string{"", "3", `"abc"`, `"0"`, `"3`} {
+		_, err = Parse(invalid)
+		assert.ErrorIs(t, err, ErrInvalidETag, invalid)
+	}
+}
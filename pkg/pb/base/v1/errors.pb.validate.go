@@ -61,6 +61,8 @@ func (m *ErrorResponse) validate(all bool) error {
 
 	// no validation rules for Message
 
+	// no validation rules for Details
+
 	if len(errors) > 0 {
 		return ErrorResponseMultiError(errors)
 	}
@@ -71,7 +71,7 @@ func (m *UserCreateRequest) validate(all bool) error {
 	if !_UserCreateRequest_Id_Pattern.MatchString(m.GetId()) {
 		err := UserCreateRequestValidationError{
 			field:  "Id",
-			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-,]+$\"",
 		}
 		if !all {
 			return err
@@ -170,46 +170,73 @@ var _ interface {
 	ErrorName() string
 } = UserCreateRequestValidationError{}
 
-var _UserCreateRequest_Id_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+var _UserCreateRequest_Id_Pattern = regexp.MustCompile("^[a-zA-Z0-9-,]+$")
 
-// Validate checks the field values on MessageResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MessageResponse) Validate() error {
+// Validate checks the field values on UserCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserCreateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MessageResponse with the rules
+// ValidateAll checks the field values on UserCreateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MessageResponseMultiError, or nil if none found.
-func (m *MessageResponse) ValidateAll() error {
+// UserCreateResponseMultiError, or nil if none found.
+func (m *UserCreateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MessageResponse) validate(all bool) error {
+func (m *UserCreateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserCreateResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserCreateResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserCreateResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return MessageResponseMultiError(errors)
+		return UserCreateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MessageResponseMultiError is an error wrapping multiple validation errors
-// returned by MessageResponse.ValidateAll() if the designated constraints
+// UserCreateResponseMultiError is an error wrapping multiple validation errors
+// returned by UserCreateResponse.ValidateAll() if the designated constraints
 // aren't met.
-type MessageResponseMultiError []error
+type UserCreateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MessageResponseMultiError) Error() string {
+func (m UserCreateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -218,11 +245,11 @@ func (m MessageResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MessageResponseMultiError) AllErrors() []error { return m }
+func (m UserCreateResponseMultiError) AllErrors() []error { return m }
 
-// MessageResponseValidationError is the validation error returned by
-// MessageResponse.Validate if the designated constraints aren't met.
-type MessageResponseValidationError struct {
+// UserCreateResponseValidationError is the validation error returned by
+// UserCreateResponse.Validate if the designated constraints aren't met.
+type UserCreateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -230,22 +257,24 @@ type MessageResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MessageResponseValidationError) Field() string { return e.field }
+func (e UserCreateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MessageResponseValidationError) Reason() string { return e.reason }
+func (e UserCreateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MessageResponseValidationError) Cause() error { return e.cause }
+func (e UserCreateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MessageResponseValidationError) Key() bool { return e.key }
+func (e UserCreateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MessageResponseValidationError) ErrorName() string { return "MessageResponseValidationError" }
+func (e UserCreateResponseValidationError) ErrorName() string {
+	return "UserCreateResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MessageResponseValidationError) Error() string {
+func (e UserCreateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -257,14 +286,14 @@ func (e MessageResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMessageResponse.%s: %s%s",
+		"invalid %sUserCreateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MessageResponseValidationError{}
+var _ error = UserCreateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -272,34 +301,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MessageResponseValidationError{}
+} = UserCreateResponseValidationError{}
 
-// Validate checks the field values on UserListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UserListRequest) Validate() error {
+// Validate checks the field values on UserBatchCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserBatchCreateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserListRequest with the rules
+// ValidateAll checks the field values on UserBatchCreateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserListRequestMultiError, or nil if none found.
-func (m *UserListRequest) ValidateAll() error {
+// UserBatchCreateRequestMultiError, or nil if none found.
+func (m *UserBatchCreateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserListRequest) validate(all bool) error {
+func (m *UserBatchCreateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetSize() <= 0 {
-		err := UserListRequestValidationError{
-			field:  "Size",
-			reason: "value must be greater than 0",
+	if l := len(m.GetUsers()); l < 1 || l > 1000 {
+		err := UserBatchCreateRequestValidationError{
+			field:  "Users",
+			reason: "value must contain between 1 and 1000 items, inclusive",
 		}
 		if !all {
 			return err
@@ -307,31 +336,56 @@ func (m *UserListRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPage() < 0 {
-		err := UserListRequestValidationError{
-			field:  "Page",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserBatchCreateRequestValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserBatchCreateRequestValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserBatchCreateRequestValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
+	// no validation rules for AllowPartial
+
 	if len(errors) > 0 {
-		return UserListRequestMultiError(errors)
+		return UserBatchCreateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserListRequestMultiError is an error wrapping multiple validation errors
-// returned by UserListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UserListRequestMultiError []error
+// UserBatchCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by UserBatchCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserBatchCreateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserListRequestMultiError) Error() string {
+func (m UserBatchCreateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -340,11 +394,11 @@ func (m UserListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserListRequestMultiError) AllErrors() []error { return m }
+func (m UserBatchCreateRequestMultiError) AllErrors() []error { return m }
 
-// UserListRequestValidationError is the validation error returned by
-// UserListRequest.Validate if the designated constraints aren't met.
-type UserListRequestValidationError struct {
+// UserBatchCreateRequestValidationError is the validation error returned by
+// UserBatchCreateRequest.Validate if the designated constraints aren't met.
+type UserBatchCreateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -352,22 +406,24 @@ type UserListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserListRequestValidationError) Field() string { return e.field }
+func (e UserBatchCreateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserListRequestValidationError) Reason() string { return e.reason }
+func (e UserBatchCreateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserListRequestValidationError) Cause() error { return e.cause }
+func (e UserBatchCreateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserListRequestValidationError) Key() bool { return e.key }
+func (e UserBatchCreateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserListRequestValidationError) ErrorName() string { return "UserListRequestValidationError" }
+func (e UserBatchCreateRequestValidationError) ErrorName() string {
+	return "UserBatchCreateRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserListRequestValidationError) Error() string {
+func (e UserBatchCreateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -379,14 +435,14 @@ func (e UserListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserListRequest.%s: %s%s",
+		"invalid %sUserBatchCreateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserListRequestValidationError{}
+var _ error = UserBatchCreateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -394,47 +450,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserListRequestValidationError{}
+} = UserBatchCreateRequestValidationError{}
 
-// Validate checks the field values on UserListResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UserListResponse) Validate() error {
+// Validate checks the field values on UserBatchCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserBatchCreateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserListResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserBatchCreateResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserListResponseMultiError, or nil if none found.
-func (m *UserListResponse) ValidateAll() error {
+// UserBatchCreateResponseMultiError, or nil if none found.
+func (m *UserBatchCreateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserListResponse) validate(all bool) error {
+func (m *UserBatchCreateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
+	for idx, item := range m.GetResults() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserListResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, UserBatchCreateResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, UserListResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, UserBatchCreateResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -442,8 +498,8 @@ func (m *UserListResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return UserListResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
+				return UserBatchCreateResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -453,19 +509,19 @@ func (m *UserListResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UserListResponseMultiError(errors)
+		return UserBatchCreateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserListResponseMultiError is an error wrapping multiple validation errors
-// returned by UserListResponse.ValidateAll() if the designated constraints
-// aren't met.
-type UserListResponseMultiError []error
+// UserBatchCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by UserBatchCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserBatchCreateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserListResponseMultiError) Error() string {
+func (m UserBatchCreateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -474,11 +530,11 @@ func (m UserListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserListResponseMultiError) AllErrors() []error { return m }
+func (m UserBatchCreateResponseMultiError) AllErrors() []error { return m }
 
-// UserListResponseValidationError is the validation error returned by
-// UserListResponse.Validate if the designated constraints aren't met.
-type UserListResponseValidationError struct {
+// UserBatchCreateResponseValidationError is the validation error returned by
+// UserBatchCreateResponse.Validate if the designated constraints aren't met.
+type UserBatchCreateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -486,22 +542,24 @@ type UserListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserListResponseValidationError) Field() string { return e.field }
+func (e UserBatchCreateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserListResponseValidationError) Reason() string { return e.reason }
+func (e UserBatchCreateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserListResponseValidationError) Cause() error { return e.cause }
+func (e UserBatchCreateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserListResponseValidationError) Key() bool { return e.key }
+func (e UserBatchCreateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserListResponseValidationError) ErrorName() string { return "UserListResponseValidationError" }
+func (e UserBatchCreateResponseValidationError) ErrorName() string {
+	return "UserBatchCreateResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserListResponseValidationError) Error() string {
+func (e UserBatchCreateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -513,14 +571,14 @@ func (e UserListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserListResponse.%s: %s%s",
+		"invalid %sUserBatchCreateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserListResponseValidationError{}
+var _ error = UserBatchCreateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -528,4 +586,4721 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserListResponseValidationError{}
+} = UserBatchCreateResponseValidationError{}
+
+// Validate checks the field values on UserResult with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserResultMultiError, or
+// nil if none found.
+func (m *UserResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserResultValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserResultValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserResultValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserResultValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserResultMultiError is an error wrapping multiple validation errors
+// returned by UserResult.ValidateAll() if the designated constraints aren't met.
+type UserResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserResultMultiError) AllErrors() []error { return m }
+
+// UserResultValidationError is the validation error returned by
+// UserResult.Validate if the designated constraints aren't met.
+type UserResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserResultValidationError) ErrorName() string { return "UserResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserResultValidationError{}
+
+// Validate checks the field values on MessageResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MessageResponseMultiError, or nil if none found.
+func (m *MessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return MessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageResponseMultiError is an error wrapping multiple validation errors
+// returned by MessageResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageResponseMultiError) AllErrors() []error { return m }
+
+// MessageResponseValidationError is the validation error returned by
+// MessageResponse.Validate if the designated constraints aren't met.
+type MessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageResponseValidationError) ErrorName() string { return "MessageResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageResponseValidationError{}
+
+// Validate checks the field values on UserListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserListRequestMultiError, or nil if none found.
+func (m *UserListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSize() <= 0 {
+		err := UserListRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := UserListRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageToken() != "" {
+
+		if len(m.GetPageToken()) > 512 {
+			err := UserListRequestValidationError{
+				field:  "PageToken",
+				reason: "value length must be at most 512 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetNamePrefix() != "" {
+
+		if len(m.GetNamePrefix()) > 64 {
+			err := UserListRequestValidationError{
+				field:  "NamePrefix",
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetNameContains() != "" {
+
+		if len(m.GetNameContains()) > 64 {
+			err := UserListRequestValidationError{
+				field:  "NameContains",
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserListRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserListRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserListRequestValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserListRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserListRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserListRequestValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetOrderBy() != "" {
+
+		if !_UserListRequest_OrderBy_Pattern.MatchString(m.GetOrderBy()) {
+			err := UserListRequestValidationError{
+				field:  "OrderBy",
+				reason: "value does not match regex pattern \"^(id|name|created_at)( (asc|desc))?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for SkipTotalSize
+
+	// no validation rules for ShowDeleted
+
+	if len(errors) > 0 {
+		return UserListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserListRequestMultiError is an error wrapping multiple validation errors
+// returned by UserListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserListRequestMultiError) AllErrors() []error { return m }
+
+// UserListRequestValidationError is the validation error returned by
+// UserListRequest.Validate if the designated constraints aren't met.
+type UserListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserListRequestValidationError) ErrorName() string { return "UserListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserListRequestValidationError{}
+
+var _UserListRequest_OrderBy_Pattern = regexp.MustCompile("^(id|name|created_at)( (asc|desc))?$")
+
+// Validate checks the field values on UserListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserListResponseMultiError, or nil if none found.
+func (m *UserListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserListResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserListResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserListResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
+	if len(errors) > 0 {
+		return UserListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserListResponseMultiError is an error wrapping multiple validation errors
+// returned by UserListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UserListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserListResponseMultiError) AllErrors() []error { return m }
+
+// UserListResponseValidationError is the validation error returned by
+// UserListResponse.Validate if the designated constraints aren't met.
+type UserListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserListResponseValidationError) ErrorName() string { return "UserListResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserListResponseValidationError{}
+
+// Validate checks the field values on UserGetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserGetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserGetRequestMultiError,
+// or nil if none found.
+func (m *UserGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UserGetRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserGetRequestMultiError is an error wrapping multiple validation errors
+// returned by UserGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserGetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserGetRequestMultiError) AllErrors() []error { return m }
+
+// UserGetRequestValidationError is the validation error returned by
+// UserGetRequest.Validate if the designated constraints aren't met.
+type UserGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserGetRequestValidationError) ErrorName() string { return "UserGetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserGetRequestValidationError{}
+
+// Validate checks the field values on UserGetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserGetResponseMultiError, or nil if none found.
+func (m *UserGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserGetResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserGetResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserGetResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserGetResponseMultiError is an error wrapping multiple validation errors
+// returned by UserGetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UserGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserGetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserGetResponseMultiError) AllErrors() []error { return m }
+
+// UserGetResponseValidationError is the validation error returned by
+// UserGetResponse.Validate if the designated constraints aren't met.
+type UserGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserGetResponseValidationError) ErrorName() string { return "UserGetResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserGetResponseValidationError{}
+
+// Validate checks the field values on UserBatchGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserBatchGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserBatchGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserBatchGetRequestMultiError, or nil if none found.
+func (m *UserBatchGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserBatchGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetIds()); l < 1 || l > 1000 {
+		err := UserBatchGetRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := UserBatchGetRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserBatchGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserBatchGetRequestMultiError is an error wrapping multiple validation
+// errors returned by UserBatchGetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserBatchGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserBatchGetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserBatchGetRequestMultiError) AllErrors() []error { return m }
+
+// UserBatchGetRequestValidationError is the validation error returned by
+// UserBatchGetRequest.Validate if the designated constraints aren't met.
+type UserBatchGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserBatchGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserBatchGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserBatchGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserBatchGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserBatchGetRequestValidationError) ErrorName() string {
+	return "UserBatchGetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserBatchGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserBatchGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserBatchGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserBatchGetRequestValidationError{}
+
+// Validate checks the field values on UserBatchGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserBatchGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserBatchGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserBatchGetResponseMultiError, or nil if none found.
+func (m *UserBatchGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserBatchGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserBatchGetResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserBatchGetResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserBatchGetResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserBatchGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserBatchGetResponseMultiError is an error wrapping multiple validation
+// errors returned by UserBatchGetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserBatchGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserBatchGetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserBatchGetResponseMultiError) AllErrors() []error { return m }
+
+// UserBatchGetResponseValidationError is the validation error returned by
+// UserBatchGetResponse.Validate if the designated constraints aren't met.
+type UserBatchGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserBatchGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserBatchGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserBatchGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserBatchGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserBatchGetResponseValidationError) ErrorName() string {
+	return "UserBatchGetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserBatchGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserBatchGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserBatchGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserBatchGetResponseValidationError{}
+
+// Validate checks the field values on UserExportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserExportRequestMultiError, or nil if none found.
+func (m *UserExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShowDeleted
+
+	if len(errors) > 0 {
+		return UserExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserExportRequestMultiError is an error wrapping multiple validation errors
+// returned by UserExportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserExportRequestMultiError) AllErrors() []error { return m }
+
+// UserExportRequestValidationError is the validation error returned by
+// UserExportRequest.Validate if the designated constraints aren't met.
+type UserExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserExportRequestValidationError) ErrorName() string {
+	return "UserExportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserExportRequestValidationError{}
+
+// Validate checks the field values on UserExportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserExportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserExportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserExportResponseMultiError, or nil if none found.
+func (m *UserExportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserExportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserExportResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserExportResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserExportResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserExportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserExportResponseMultiError is an error wrapping multiple validation errors
+// returned by UserExportResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UserExportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserExportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserExportResponseMultiError) AllErrors() []error { return m }
+
+// UserExportResponseValidationError is the validation error returned by
+// UserExportResponse.Validate if the designated constraints aren't met.
+type UserExportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserExportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserExportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserExportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserExportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserExportResponseValidationError) ErrorName() string {
+	return "UserExportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserExportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserExportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserExportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserExportResponseValidationError{}
+
+// Validate checks the field values on UserImportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserImportRequestMultiError, or nil if none found.
+func (m *UserImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetId()) > 64 {
+		err := UserImportRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UserImportRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := UserImportRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-,]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) > 64 {
+		err := UserImportRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserImportRequestMultiError is an error wrapping multiple validation errors
+// returned by UserImportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserImportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserImportRequestMultiError) AllErrors() []error { return m }
+
+// UserImportRequestValidationError is the validation error returned by
+// UserImportRequest.Validate if the designated constraints aren't met.
+type UserImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserImportRequestValidationError) ErrorName() string {
+	return "UserImportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserImportRequestValidationError{}
+
+var _UserImportRequest_Id_Pattern = regexp.MustCompile("^[a-zA-Z0-9-,]+$")
+
+// Validate checks the field values on UserImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserImportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserImportResponseMultiError, or nil if none found.
+func (m *UserImportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserImportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Received
+
+	// no validation rules for Created
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserImportResponseValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserImportResponseValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserImportResponseValidationError{
+					field:  fmt.Sprintf("Failures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserImportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserImportResponseMultiError is an error wrapping multiple validation errors
+// returned by UserImportResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UserImportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserImportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserImportResponseMultiError) AllErrors() []error { return m }
+
+// UserImportResponseValidationError is the validation error returned by
+// UserImportResponse.Validate if the designated constraints aren't met.
+type UserImportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserImportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserImportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserImportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserImportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserImportResponseValidationError) ErrorName() string {
+	return "UserImportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserImportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserImportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserImportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserImportResponseValidationError{}
+
+// Validate checks the field values on UserImportFailure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserImportFailure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserImportFailure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserImportFailureMultiError, or nil if none found.
+func (m *UserImportFailure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserImportFailure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserImportFailureValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserImportFailureValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserImportFailureValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserImportFailureMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserImportFailureMultiError is an error wrapping multiple validation errors
+// returned by UserImportFailure.ValidateAll() if the designated constraints
+// aren't met.
+type UserImportFailureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserImportFailureMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserImportFailureMultiError) AllErrors() []error { return m }
+
+// UserImportFailureValidationError is the validation error returned by
+// UserImportFailure.Validate if the designated constraints aren't met.
+type UserImportFailureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserImportFailureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserImportFailureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserImportFailureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserImportFailureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserImportFailureValidationError) ErrorName() string {
+	return "UserImportFailureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserImportFailureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserImportFailure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserImportFailureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserImportFailureValidationError{}
+
+// Validate checks the field values on UserWatchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserWatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserWatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserWatchRequestMultiError, or nil if none found.
+func (m *UserWatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserWatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetResumeToken()) > 256 {
+		err := UserWatchRequestValidationError{
+			field:  "ResumeToken",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserWatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserWatchRequestMultiError is an error wrapping multiple validation errors
+// returned by UserWatchRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserWatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserWatchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserWatchRequestMultiError) AllErrors() []error { return m }
+
+// UserWatchRequestValidationError is the validation error returned by
+// UserWatchRequest.Validate if the designated constraints aren't met.
+type UserWatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserWatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserWatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserWatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserWatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserWatchRequestValidationError) ErrorName() string { return "UserWatchRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserWatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserWatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserWatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserWatchRequestValidationError{}
+
+// Validate checks the field values on UserWatchResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserWatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserWatchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserWatchResponseMultiError, or nil if none found.
+func (m *UserWatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserWatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserWatchResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserWatchResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserWatchResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserWatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserWatchResponseMultiError is an error wrapping multiple validation errors
+// returned by UserWatchResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UserWatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserWatchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserWatchResponseMultiError) AllErrors() []error { return m }
+
+// UserWatchResponseValidationError is the validation error returned by
+// UserWatchResponse.Validate if the designated constraints aren't met.
+type UserWatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserWatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserWatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserWatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserWatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserWatchResponseValidationError) ErrorName() string {
+	return "UserWatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserWatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserWatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserWatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserWatchResponseValidationError{}
+
+// Validate checks the field values on UserUpdateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserUpdateRequestMultiError, or nil if none found.
+func (m *UserUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UserUpdateRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetName()); l < 1 || l > 64 {
+		err := UserUpdateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return UserUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserUpdateRequestMultiError is an error wrapping multiple validation errors
+// returned by UserUpdateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserUpdateRequestMultiError) AllErrors() []error { return m }
+
+// UserUpdateRequestValidationError is the validation error returned by
+// UserUpdateRequest.Validate if the designated constraints aren't met.
+type UserUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserUpdateRequestValidationError) ErrorName() string {
+	return "UserUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserUpdateRequestValidationError{}
+
+// Validate checks the field values on UserUpdateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserUpdateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserUpdateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserUpdateResponseMultiError, or nil if none found.
+func (m *UserUpdateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserUpdateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserUpdateResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserUpdateResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserUpdateResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserUpdateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserUpdateResponseMultiError is an error wrapping multiple validation errors
+// returned by UserUpdateResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UserUpdateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserUpdateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserUpdateResponseMultiError) AllErrors() []error { return m }
+
+// UserUpdateResponseValidationError is the validation error returned by
+// UserUpdateResponse.Validate if the designated constraints aren't met.
+type UserUpdateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserUpdateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserUpdateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserUpdateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserUpdateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserUpdateResponseValidationError) ErrorName() string {
+	return "UserUpdateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserUpdateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserUpdateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserUpdateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserUpdateResponseValidationError{}
+
+// Validate checks the field values on UserDeleteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserDeleteRequestMultiError, or nil if none found.
+func (m *UserDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UserDeleteRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return UserDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDeleteRequestMultiError is an error wrapping multiple validation errors
+// returned by UserDeleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDeleteRequestMultiError) AllErrors() []error { return m }
+
+// UserDeleteRequestValidationError is the validation error returned by
+// UserDeleteRequest.Validate if the designated constraints aren't met.
+type UserDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDeleteRequestValidationError) ErrorName() string {
+	return "UserDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDeleteRequestValidationError{}
+
+// Validate checks the field values on UserUndeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserUndeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserUndeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserUndeleteRequestMultiError, or nil if none found.
+func (m *UserUndeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserUndeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UserUndeleteRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserUndeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserUndeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by UserUndeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserUndeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserUndeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserUndeleteRequestMultiError) AllErrors() []error { return m }
+
+// UserUndeleteRequestValidationError is the validation error returned by
+// UserUndeleteRequest.Validate if the designated constraints aren't met.
+type UserUndeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserUndeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserUndeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserUndeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserUndeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserUndeleteRequestValidationError) ErrorName() string {
+	return "UserUndeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserUndeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserUndeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserUndeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserUndeleteRequestValidationError{}
+
+// Validate checks the field values on UserUndeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserUndeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserUndeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserUndeleteResponseMultiError, or nil if none found.
+func (m *UserUndeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserUndeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserUndeleteResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserUndeleteResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserUndeleteResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserUndeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserUndeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by UserUndeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserUndeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserUndeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserUndeleteResponseMultiError) AllErrors() []error { return m }
+
+// UserUndeleteResponseValidationError is the validation error returned by
+// UserUndeleteResponse.Validate if the designated constraints aren't met.
+type UserUndeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserUndeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserUndeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserUndeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserUndeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserUndeleteResponseValidationError) ErrorName() string {
+	return "UserUndeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserUndeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserUndeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserUndeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserUndeleteResponseValidationError{}
+
+// Validate checks the field values on AuditEventListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditEventListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEventListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditEventListRequestMultiError, or nil if none found.
+func (m *AuditEventListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEventListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSize() <= 0 {
+		err := AuditEventListRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageToken() != "" {
+
+		if len(m.GetPageToken()) > 512 {
+			err := AuditEventListRequestValidationError{
+				field:  "PageToken",
+				reason: "value length must be at most 512 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetActor() != "" {
+
+		if len(m.GetActor()) > 256 {
+			err := AuditEventListRequestValidationError{
+				field:  "Actor",
+				reason: "value length must be at most 256 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventListRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventListRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventListRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventListRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventListRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventListRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditEventListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEventListRequestMultiError is an error wrapping multiple validation
+// errors returned by AuditEventListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuditEventListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventListRequestMultiError) AllErrors() []error { return m }
+
+// AuditEventListRequestValidationError is the validation error returned by
+// AuditEventListRequest.Validate if the designated constraints aren't met.
+type AuditEventListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventListRequestValidationError) ErrorName() string {
+	return "AuditEventListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditEventListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEventListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventListRequestValidationError{}
+
+// Validate checks the field values on AuditEventListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditEventListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEventListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditEventListResponseMultiError, or nil if none found.
+func (m *AuditEventListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEventListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditEventListResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditEventListResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditEventListResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return AuditEventListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEventListResponseMultiError is an error wrapping multiple validation
+// errors returned by AuditEventListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AuditEventListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventListResponseMultiError) AllErrors() []error { return m }
+
+// AuditEventListResponseValidationError is the validation error returned by
+// AuditEventListResponse.Validate if the designated constraints aren't met.
+type AuditEventListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventListResponseValidationError) ErrorName() string {
+	return "AuditEventListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditEventListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEventListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventListResponseValidationError{}
+
+// Validate checks the field values on TenantCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantCreateRequestMultiError, or nil if none found.
+func (m *TenantCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) > 64 {
+		err := TenantCreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TenantCreateRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := TenantCreateRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TenantCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TenantCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantCreateRequestMultiError) AllErrors() []error { return m }
+
+// TenantCreateRequestValidationError is the validation error returned by
+// TenantCreateRequest.Validate if the designated constraints aren't met.
+type TenantCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantCreateRequestValidationError) ErrorName() string {
+	return "TenantCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantCreateRequestValidationError{}
+
+var _TenantCreateRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9-]+$")
+
+// Validate checks the field values on TenantCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantCreateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantCreateResponseMultiError, or nil if none found.
+func (m *TenantCreateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantCreateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantCreateResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TenantCreateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TenantCreateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantCreateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantCreateResponseMultiError) AllErrors() []error { return m }
+
+// TenantCreateResponseValidationError is the validation error returned by
+// TenantCreateResponse.Validate if the designated constraints aren't met.
+type TenantCreateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantCreateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantCreateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantCreateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantCreateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantCreateResponseValidationError) ErrorName() string {
+	return "TenantCreateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantCreateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantCreateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantCreateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantCreateResponseValidationError{}
+
+// Validate checks the field values on TenantListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantListRequestMultiError, or nil if none found.
+func (m *TenantListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSize() <= 0 {
+		err := TenantListRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageToken() != "" {
+
+		if len(m.GetPageToken()) > 512 {
+			err := TenantListRequestValidationError{
+				field:  "PageToken",
+				reason: "value length must be at most 512 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TenantListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantListRequestMultiError is an error wrapping multiple validation errors
+// returned by TenantListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TenantListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantListRequestMultiError) AllErrors() []error { return m }
+
+// TenantListRequestValidationError is the validation error returned by
+// TenantListRequest.Validate if the designated constraints aren't met.
+type TenantListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantListRequestValidationError) ErrorName() string {
+	return "TenantListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantListRequestValidationError{}
+
+// Validate checks the field values on TenantListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantListResponseMultiError, or nil if none found.
+func (m *TenantListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTenants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TenantListResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TenantListResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TenantListResponseValidationError{
+					field:  fmt.Sprintf("Tenants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return TenantListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantListResponseMultiError is an error wrapping multiple validation errors
+// returned by TenantListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TenantListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantListResponseMultiError) AllErrors() []error { return m }
+
+// TenantListResponseValidationError is the validation error returned by
+// TenantListResponse.Validate if the designated constraints aren't met.
+type TenantListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantListResponseValidationError) ErrorName() string {
+	return "TenantListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantListResponseValidationError{}
+
+// Validate checks the field values on TenantDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantDeleteRequestMultiError, or nil if none found.
+func (m *TenantDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := TenantDeleteRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TenantDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TenantDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantDeleteRequestMultiError) AllErrors() []error { return m }
+
+// TenantDeleteRequestValidationError is the validation error returned by
+// TenantDeleteRequest.Validate if the designated constraints aren't met.
+type TenantDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantDeleteRequestValidationError) ErrorName() string {
+	return "TenantDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantDeleteRequestValidationError{}
+
+// Validate checks the field values on ApiKeyCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiKeyCreateRequestMultiError, or nil if none found.
+func (m *ApiKeyCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetName()); l < 1 || l > 64 {
+		err := ApiKeyCreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRoles()) > 16 {
+		err := ApiKeyCreateRequestValidationError{
+			field:  "Roles",
+			reason: "value must contain no more than 16 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if l := len(item); l < 1 || l > 64 {
+			err := ApiKeyCreateRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "value length must be between 1 and 64 bytes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if t := m.GetExpiresAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = ApiKeyCreateRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			now := time.Now()
+
+			if ts.Sub(now) <= 0 {
+				err := ApiKeyCreateRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "value must be greater than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApiKeyCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by ApiKeyCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApiKeyCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyCreateRequestMultiError) AllErrors() []error { return m }
+
+// ApiKeyCreateRequestValidationError is the validation error returned by
+// ApiKeyCreateRequest.Validate if the designated constraints aren't met.
+type ApiKeyCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyCreateRequestValidationError) ErrorName() string {
+	return "ApiKeyCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyCreateRequestValidationError{}
+
+// Validate checks the field values on ApiKeyCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyCreateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiKeyCreateResponseMultiError, or nil if none found.
+func (m *ApiKeyCreateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyCreateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApiKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyCreateResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyCreateResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyCreateResponseValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return ApiKeyCreateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by ApiKeyCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApiKeyCreateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyCreateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyCreateResponseMultiError) AllErrors() []error { return m }
+
+// ApiKeyCreateResponseValidationError is the validation error returned by
+// ApiKeyCreateResponse.Validate if the designated constraints aren't met.
+type ApiKeyCreateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyCreateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyCreateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyCreateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyCreateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyCreateResponseValidationError) ErrorName() string {
+	return "ApiKeyCreateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyCreateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyCreateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyCreateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyCreateResponseValidationError{}
+
+// Validate checks the field values on ApiKeyListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiKeyListRequestMultiError, or nil if none found.
+func (m *ApiKeyListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSize() <= 0 {
+		err := ApiKeyListRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageToken() != "" {
+
+		if len(m.GetPageToken()) > 512 {
+			err := ApiKeyListRequestValidationError{
+				field:  "PageToken",
+				reason: "value length must be at most 512 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ApiKeyListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyListRequestMultiError is an error wrapping multiple validation errors
+// returned by ApiKeyListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ApiKeyListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyListRequestMultiError) AllErrors() []error { return m }
+
+// ApiKeyListRequestValidationError is the validation error returned by
+// ApiKeyListRequest.Validate if the designated constraints aren't met.
+type ApiKeyListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyListRequestValidationError) ErrorName() string {
+	return "ApiKeyListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyListRequestValidationError{}
+
+// Validate checks the field values on ApiKeyListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiKeyListResponseMultiError, or nil if none found.
+func (m *ApiKeyListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApiKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApiKeyListResponseValidationError{
+						field:  fmt.Sprintf("ApiKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApiKeyListResponseValidationError{
+						field:  fmt.Sprintf("ApiKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApiKeyListResponseValidationError{
+					field:  fmt.Sprintf("ApiKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ApiKeyListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyListResponseMultiError is an error wrapping multiple validation errors
+// returned by ApiKeyListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ApiKeyListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyListResponseMultiError) AllErrors() []error { return m }
+
+// ApiKeyListResponseValidationError is the validation error returned by
+// ApiKeyListResponse.Validate if the designated constraints aren't met.
+type ApiKeyListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyListResponseValidationError) ErrorName() string {
+	return "ApiKeyListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyListResponseValidationError{}
+
+// Validate checks the field values on ApiKeyRevokeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyRevokeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyRevokeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiKeyRevokeRequestMultiError, or nil if none found.
+func (m *ApiKeyRevokeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyRevokeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ApiKeyRevokeRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApiKeyRevokeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyRevokeRequestMultiError is an error wrapping multiple validation
+// errors returned by ApiKeyRevokeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApiKeyRevokeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyRevokeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyRevokeRequestMultiError) AllErrors() []error { return m }
+
+// ApiKeyRevokeRequestValidationError is the validation error returned by
+// ApiKeyRevokeRequest.Validate if the designated constraints aren't met.
+type ApiKeyRevokeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyRevokeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyRevokeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyRevokeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyRevokeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyRevokeRequestValidationError) ErrorName() string {
+	return "ApiKeyRevokeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyRevokeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyRevokeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyRevokeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyRevokeRequestValidationError{}
+
+// Validate checks the field values on ApiKeyRevokeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyRevokeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyRevokeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiKeyRevokeResponseMultiError, or nil if none found.
+func (m *ApiKeyRevokeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyRevokeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApiKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyRevokeResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyRevokeResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyRevokeResponseValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApiKeyRevokeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyRevokeResponseMultiError is an error wrapping multiple validation
+// errors returned by ApiKeyRevokeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApiKeyRevokeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyRevokeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyRevokeResponseMultiError) AllErrors() []error { return m }
+
+// ApiKeyRevokeResponseValidationError is the validation error returned by
+// ApiKeyRevokeResponse.Validate if the designated constraints aren't met.
+type ApiKeyRevokeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyRevokeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyRevokeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyRevokeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyRevokeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyRevokeResponseValidationError) ErrorName() string {
+	return "ApiKeyRevokeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyRevokeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyRevokeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyRevokeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyRevokeResponseValidationError{}
+
+// Validate checks the field values on ApiKeyRotateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyRotateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyRotateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiKeyRotateRequestMultiError, or nil if none found.
+func (m *ApiKeyRotateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyRotateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ApiKeyRotateRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if t := m.GetExpiresAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = ApiKeyRotateRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			now := time.Now()
+
+			if ts.Sub(now) <= 0 {
+				err := ApiKeyRotateRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "value must be greater than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApiKeyRotateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyRotateRequestMultiError is an error wrapping multiple validation
+// errors returned by ApiKeyRotateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApiKeyRotateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyRotateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyRotateRequestMultiError) AllErrors() []error { return m }
+
+// ApiKeyRotateRequestValidationError is the validation error returned by
+// ApiKeyRotateRequest.Validate if the designated constraints aren't met.
+type ApiKeyRotateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyRotateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyRotateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyRotateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyRotateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyRotateRequestValidationError) ErrorName() string {
+	return "ApiKeyRotateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyRotateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyRotateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyRotateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyRotateRequestValidationError{}
+
+// Validate checks the field values on ApiKeyRotateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyRotateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyRotateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiKeyRotateResponseMultiError, or nil if none found.
+func (m *ApiKeyRotateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyRotateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApiKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyRotateResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyRotateResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyRotateResponseValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return ApiKeyRotateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyRotateResponseMultiError is an error wrapping multiple validation
+// errors returned by ApiKeyRotateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApiKeyRotateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyRotateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyRotateResponseMultiError) AllErrors() []error { return m }
+
+// ApiKeyRotateResponseValidationError is the validation error returned by
+// ApiKeyRotateResponse.Validate if the designated constraints aren't met.
+type ApiKeyRotateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyRotateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyRotateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyRotateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyRotateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyRotateResponseValidationError) ErrorName() string {
+	return "ApiKeyRotateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyRotateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyRotateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyRotateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyRotateResponseValidationError{}
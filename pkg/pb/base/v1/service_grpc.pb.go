@@ -19,8 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	UserService_Create_FullMethodName = "/base.v1.UserService/Create"
-	UserService_List_FullMethodName   = "/base.v1.UserService/List"
+	UserService_Create_FullMethodName      = "/base.v1.UserService/Create"
+	UserService_BatchCreate_FullMethodName = "/base.v1.UserService/BatchCreate"
+	UserService_List_FullMethodName        = "/base.v1.UserService/List"
+	UserService_Get_FullMethodName         = "/base.v1.UserService/Get"
+	UserService_BatchGet_FullMethodName    = "/base.v1.UserService/BatchGet"
+	UserService_Export_FullMethodName      = "/base.v1.UserService/Export"
+	UserService_Import_FullMethodName      = "/base.v1.UserService/Import"
+	UserService_Watch_FullMethodName       = "/base.v1.UserService/Watch"
+	UserService_Update_FullMethodName      = "/base.v1.UserService/Update"
+	UserService_Delete_FullMethodName      = "/base.v1.UserService/Delete"
+	UserService_Undelete_FullMethodName    = "/base.v1.UserService/Undelete"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -28,11 +37,41 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserServiceClient interface {
 	// Create is a unary RPC to create a new user.
-	// It requires a UserCreateRequest and returns a MessageResponse.
-	Create(ctx context.Context, in *UserCreateRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	// It requires a UserCreateRequest and returns the created User.
+	Create(ctx context.Context, in *UserCreateRequest, opts ...grpc.CallOption) (*UserCreateResponse, error)
+	// BatchCreate is a unary RPC to create many users in one transaction.
+	// By default the batch is all-or-nothing and fails with the error of the first failing user, with
+	// allow_partial the valid users are created and every failing user is reported in its result.
+	BatchCreate(ctx context.Context, in *UserBatchCreateRequest, opts ...grpc.CallOption) (*UserBatchCreateResponse, error)
 	// List is a unary RPC to get a list of all users.
 	// It requires a UserListRequest and returns a UserListResponse.
 	List(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserListResponse, error)
+	// Get is a unary RPC to get a single user by its id.
+	// It requires a UserGetRequest and returns a UserGetResponse.
+	Get(ctx context.Context, in *UserGetRequest, opts ...grpc.CallOption) (*UserGetResponse, error)
+	// BatchGet is a unary RPC to get many users by their ids.
+	// It requires a UserBatchGetRequest and returns a result per id, ids that are not found carry an error.
+	BatchGet(ctx context.Context, in *UserBatchGetRequest, opts ...grpc.CallOption) (*UserBatchGetResponse, error)
+	// Export is a server-streaming RPC to read all users, it streams one UserExportResponse per user.
+	// Over HTTP the users are returned as newline-delimited JSON.
+	Export(ctx context.Context, in *UserExportRequest, opts ...grpc.CallOption) (UserService_ExportClient, error)
+	// Import is a client-streaming RPC to create users from a stream of UserImportRequest messages.
+	// Users are written in batches, failing users are skipped and the stream ends with a summary.
+	Import(ctx context.Context, opts ...grpc.CallOption) (UserService_ImportClient, error)
+	// Watch is a server-streaming RPC to follow the changes made to users. It streams one UserWatchResponse per
+	// change, in the order the changes were committed, and keeps the stream open waiting for new changes.
+	// Over HTTP the events are returned as newline-delimited JSON.
+	Watch(ctx context.Context, in *UserWatchRequest, opts ...grpc.CallOption) (UserService_WatchClient, error)
+	// Update is a unary RPC to update the name of an existing user.
+	// It requires a UserUpdateRequest and returns a UserUpdateResponse.
+	Update(ctx context.Context, in *UserUpdateRequest, opts ...grpc.CallOption) (*UserUpdateResponse, error)
+	// Delete is a unary RPC to soft delete an existing user, deleted users are hidden until they are
+	// restored with Undelete or purged once the retention period has passed.
+	// It requires a UserDeleteRequest and returns a MessageResponse.
+	Delete(ctx context.Context, in *UserDeleteRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	// Undelete is a unary RPC to restore a deleted user that has not been purged yet.
+	// It requires a UserUndeleteRequest and returns a UserUndeleteResponse.
+	Undelete(ctx context.Context, in *UserUndeleteRequest, opts ...grpc.CallOption) (*UserUndeleteResponse, error)
 }
 
 type userServiceClient struct {
@@ -43,9 +82,9 @@ func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
 	return &userServiceClient{cc}
 }
 
-func (c *userServiceClient) Create(ctx context.Context, in *UserCreateRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+func (c *userServiceClient) Create(ctx context.Context, in *UserCreateRequest, opts ...grpc.CallOption) (*UserCreateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MessageResponse)
+	out := new(UserCreateResponse)
 	err := c.cc.Invoke(ctx, UserService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -53,6 +92,16 @@ func (c *userServiceClient) Create(ctx context.Context, in *UserCreateRequest, o
 	return out, nil
 }
 
+func (c *userServiceClient) BatchCreate(ctx context.Context, in *UserBatchCreateRequest, opts ...grpc.CallOption) (*UserBatchCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserBatchCreateResponse)
+	err := c.cc.Invoke(ctx, UserService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) List(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserListResponse)
@@ -63,16 +112,197 @@ func (c *userServiceClient) List(ctx context.Context, in *UserListRequest, opts
 	return out, nil
 }
 
+func (c *userServiceClient) Get(ctx context.Context, in *UserGetRequest, opts ...grpc.CallOption) (*UserGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserGetResponse)
+	err := c.cc.Invoke(ctx, UserService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BatchGet(ctx context.Context, in *UserBatchGetRequest, opts ...grpc.CallOption) (*UserBatchGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserBatchGetResponse)
+	err := c.cc.Invoke(ctx, UserService_BatchGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Export(ctx context.Context, in *UserExportRequest, opts ...grpc.CallOption) (UserService_ExportClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], UserService_Export_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceExportClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_ExportClient interface {
+	Recv() (*UserExportResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceExportClient) Recv() (*UserExportResponse, error) {
+	m := new(UserExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) Import(ctx context.Context, opts ...grpc.CallOption) (UserService_ImportClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[1], UserService_Import_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceImportClient{ClientStream: stream}
+	return x, nil
+}
+
+type UserService_ImportClient interface {
+	Send(*UserImportRequest) error
+	CloseAndRecv() (*UserImportResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceImportClient) Send(m *UserImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *userServiceImportClient) CloseAndRecv() (*UserImportResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UserImportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) Watch(ctx context.Context, in *UserWatchRequest, opts ...grpc.CallOption) (UserService_WatchClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[2], UserService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceWatchClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_WatchClient interface {
+	Recv() (*UserWatchResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceWatchClient) Recv() (*UserWatchResponse, error) {
+	m := new(UserWatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) Update(ctx context.Context, in *UserUpdateRequest, opts ...grpc.CallOption) (*UserUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserUpdateResponse)
+	err := c.cc.Invoke(ctx, UserService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Delete(ctx context.Context, in *UserDeleteRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, UserService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Undelete(ctx context.Context, in *UserUndeleteRequest, opts ...grpc.CallOption) (*UserUndeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserUndeleteResponse)
+	err := c.cc.Invoke(ctx, UserService_Undelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
 type UserServiceServer interface {
 	// Create is a unary RPC to create a new user.
-	// It requires a UserCreateRequest and returns a MessageResponse.
-	Create(context.Context, *UserCreateRequest) (*MessageResponse, error)
+	// It requires a UserCreateRequest and returns the created User.
+	Create(context.Context, *UserCreateRequest) (*UserCreateResponse, error)
+	// BatchCreate is a unary RPC to create many users in one transaction.
+	// By default the batch is all-or-nothing and fails with the error of the first failing user, with
+	// allow_partial the valid users are created and every failing user is reported in its result.
+	BatchCreate(context.Context, *UserBatchCreateRequest) (*UserBatchCreateResponse, error)
 	// List is a unary RPC to get a list of all users.
 	// It requires a UserListRequest and returns a UserListResponse.
 	List(context.Context, *UserListRequest) (*UserListResponse, error)
+	// Get is a unary RPC to get a single user by its id.
+	// It requires a UserGetRequest and returns a UserGetResponse.
+	Get(context.Context, *UserGetRequest) (*UserGetResponse, error)
+	// BatchGet is a unary RPC to get many users by their ids.
+	// It requires a UserBatchGetRequest and returns a result per id, ids that are not found carry an error.
+	BatchGet(context.Context, *UserBatchGetRequest) (*UserBatchGetResponse, error)
+	// Export is a server-streaming RPC to read all users, it streams one UserExportResponse per user.
+	// Over HTTP the users are returned as newline-delimited JSON.
+	Export(*UserExportRequest, UserService_ExportServer) error
+	// Import is a client-streaming RPC to create users from a stream of UserImportRequest messages.
+	// Users are written in batches, failing users are skipped and the stream ends with a summary.
+	Import(UserService_ImportServer) error
+	// Watch is a server-streaming RPC to follow the changes made to users. It streams one UserWatchResponse per
+	// change, in the order the changes were committed, and keeps the stream open waiting for new changes.
+	// Over HTTP the events are returned as newline-delimited JSON.
+	Watch(*UserWatchRequest, UserService_WatchServer) error
+	// Update is a unary RPC to update the name of an existing user.
+	// It requires a UserUpdateRequest and returns a UserUpdateResponse.
+	Update(context.Context, *UserUpdateRequest) (*UserUpdateResponse, error)
+	// Delete is a unary RPC to soft delete an existing user, deleted users are hidden until they are
+	// restored with Undelete or purged once the retention period has passed.
+	// It requires a UserDeleteRequest and returns a MessageResponse.
+	Delete(context.Context, *UserDeleteRequest) (*MessageResponse, error)
+	// Undelete is a unary RPC to restore a deleted user that has not been purged yet.
+	// It requires a UserUndeleteRequest and returns a UserUndeleteResponse.
+	Undelete(context.Context, *UserUndeleteRequest) (*UserUndeleteResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -80,12 +310,39 @@ type UserServiceServer interface {
 type UnimplementedUserServiceServer struct {
 }
 
-func (UnimplementedUserServiceServer) Create(context.Context, *UserCreateRequest) (*MessageResponse, error) {
+func (UnimplementedUserServiceServer) Create(context.Context, *UserCreateRequest) (*UserCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedUserServiceServer) BatchCreate(context.Context, *UserBatchCreateRequest) (*UserBatchCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
 func (UnimplementedUserServiceServer) List(context.Context, *UserListRequest) (*UserListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedUserServiceServer) Get(context.Context, *UserGetRequest) (*UserGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedUserServiceServer) BatchGet(context.Context, *UserBatchGetRequest) (*UserBatchGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (UnimplementedUserServiceServer) Export(*UserExportRequest, UserService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedUserServiceServer) Import(UserService_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedUserServiceServer) Watch(*UserWatchRequest, UserService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedUserServiceServer) Update(context.Context, *UserUpdateRequest) (*UserUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedUserServiceServer) Delete(context.Context, *UserDeleteRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedUserServiceServer) Undelete(context.Context, *UserUndeleteRequest) (*UserUndeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelete not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -117,6 +374,24 @@ func _UserService_Create_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserBatchCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BatchCreate(ctx, req.(*UserBatchCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserListRequest)
 	if err := dec(in); err != nil {
@@ -135,6 +410,164 @@ func _UserService_List_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Get(ctx, req.(*UserGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserBatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BatchGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BatchGet(ctx, req.(*UserBatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UserExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).Export(m, &userServiceExportServer{ServerStream: stream})
+}
+
+type UserService_ExportServer interface {
+	Send(*UserExportResponse) error
+	grpc.ServerStream
+}
+
+type userServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceExportServer) Send(m *UserExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UserService_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UserServiceServer).Import(&userServiceImportServer{ServerStream: stream})
+}
+
+type UserService_ImportServer interface {
+	SendAndClose(*UserImportResponse) error
+	Recv() (*UserImportRequest, error)
+	grpc.ServerStream
+}
+
+type userServiceImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceImportServer) SendAndClose(m *UserImportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *userServiceImportServer) Recv() (*UserImportRequest, error) {
+	m := new(UserImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _UserService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UserWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).Watch(m, &userServiceWatchServer{ServerStream: stream})
+}
+
+type UserService_WatchServer interface {
+	Send(*UserWatchResponse) error
+	grpc.ServerStream
+}
+
+type userServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceWatchServer) Send(m *UserWatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UserService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Update(ctx, req.(*UserUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Delete(ctx, req.(*UserDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserUndeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Undelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Undelete(ctx, req.(*UserUndeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -146,10 +579,557 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _UserService_Create_Handler,
 		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _UserService_BatchCreate_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _UserService_List_Handler,
 		},
+		{
+			MethodName: "Get",
+			Handler:    _UserService_Get_Handler,
+		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _UserService_BatchGet_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _UserService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _UserService_Delete_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _UserService_Undelete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _UserService_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _UserService_Import_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _UserService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "base/v1/service.proto",
+}
+
+const (
+	TenantService_Create_FullMethodName = "/base.v1.TenantService/Create"
+	TenantService_List_FullMethodName   = "/base.v1.TenantService/List"
+	TenantService_Delete_FullMethodName = "/base.v1.TenantService/Delete"
+)
+
+// TenantServiceClient is the client API for TenantService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TenantService is the service for managing the tenants users are kept apart by.
+type TenantServiceClient interface {
+	// Create is a unary RPC to create a new tenant.
+	// It requires a TenantCreateRequest and returns a TenantCreateResponse.
+	Create(ctx context.Context, in *TenantCreateRequest, opts ...grpc.CallOption) (*TenantCreateResponse, error)
+	// List is a unary RPC to get a list of all tenants.
+	// It requires a TenantListRequest and returns a TenantListResponse.
+	List(ctx context.Context, in *TenantListRequest, opts ...grpc.CallOption) (*TenantListResponse, error)
+	// Delete is a unary RPC to delete a tenant together with its users and their events.
+	// It requires a TenantDeleteRequest and returns a MessageResponse.
+	Delete(ctx context.Context, in *TenantDeleteRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+}
+
+type tenantServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenantServiceClient(cc grpc.ClientConnInterface) TenantServiceClient {
+	return &tenantServiceClient{cc}
+}
+
+func (c *tenantServiceClient) Create(ctx context.Context, in *TenantCreateRequest, opts ...grpc.CallOption) (*TenantCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantCreateResponse)
+	err := c.cc.Invoke(ctx, TenantService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) List(ctx context.Context, in *TenantListRequest, opts ...grpc.CallOption) (*TenantListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantListResponse)
+	err := c.cc.Invoke(ctx, TenantService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) Delete(ctx context.Context, in *TenantDeleteRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, TenantService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenantServiceServer is the server API for TenantService service.
+// All implementations must embed UnimplementedTenantServiceServer
+// for forward compatibility
+//
+// TenantService is the service for managing the tenants users are kept apart by.
+type TenantServiceServer interface {
+	// Create is a unary RPC to create a new tenant.
+	// It requires a TenantCreateRequest and returns a TenantCreateResponse.
+	Create(context.Context, *TenantCreateRequest) (*TenantCreateResponse, error)
+	// List is a unary RPC to get a list of all tenants.
+	// It requires a TenantListRequest and returns a TenantListResponse.
+	List(context.Context, *TenantListRequest) (*TenantListResponse, error)
+	// Delete is a unary RPC to delete a tenant together with its users and their events.
+	// It requires a TenantDeleteRequest and returns a MessageResponse.
+	Delete(context.Context, *TenantDeleteRequest) (*MessageResponse, error)
+	mustEmbedUnimplementedTenantServiceServer()
+}
+
+// UnimplementedTenantServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTenantServiceServer struct {
+}
+
+func (UnimplementedTenantServiceServer) Create(context.Context, *TenantCreateRequest) (*TenantCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTenantServiceServer) List(context.Context, *TenantListRequest) (*TenantListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTenantServiceServer) Delete(context.Context, *TenantDeleteRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTenantServiceServer) mustEmbedUnimplementedTenantServiceServer() {}
+
+// UnsafeTenantServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantServiceServer will
+// result in compilation errors.
+type UnsafeTenantServiceServer interface {
+	mustEmbedUnimplementedTenantServiceServer()
+}
+
+func RegisterTenantServiceServer(s grpc.ServiceRegistrar, srv TenantServiceServer) {
+	s.RegisterService(&TenantService_ServiceDesc, srv)
+}
+
+func _TenantService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).Create(ctx, req.(*TenantCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).List(ctx, req.(*TenantListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).Delete(ctx, req.(*TenantDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TenantService_ServiceDesc is the grpc.ServiceDesc for TenantService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenantService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.TenantService",
+	HandlerType: (*TenantServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TenantService_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TenantService_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TenantService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/service.proto",
+}
+
+const (
+	ApiKeyService_Create_FullMethodName = "/base.v1.ApiKeyService/Create"
+	ApiKeyService_List_FullMethodName   = "/base.v1.ApiKeyService/List"
+	ApiKeyService_Revoke_FullMethodName = "/base.v1.ApiKeyService/Revoke"
+	ApiKeyService_Rotate_FullMethodName = "/base.v1.ApiKeyService/Rotate"
+)
+
+// ApiKeyServiceClient is the client API for ApiKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ApiKeyService is the service for managing the API keys callers authenticate with.
+type ApiKeyServiceClient interface {
+	// Create is a unary RPC to mint a new API key in the tenant of the call.
+	// It requires an ApiKeyCreateRequest and returns an ApiKeyCreateResponse, the only one to carry the secret.
+	Create(ctx context.Context, in *ApiKeyCreateRequest, opts ...grpc.CallOption) (*ApiKeyCreateResponse, error)
+	// List is a unary RPC to get a list of the API keys of the tenant of the call.
+	// It requires an ApiKeyListRequest and returns an ApiKeyListResponse.
+	List(ctx context.Context, in *ApiKeyListRequest, opts ...grpc.CallOption) (*ApiKeyListResponse, error)
+	// Revoke is a unary RPC to revoke an API key, its callers are rejected from then on.
+	// It requires an ApiKeyRevokeRequest and returns an ApiKeyRevokeResponse.
+	Revoke(ctx context.Context, in *ApiKeyRevokeRequest, opts ...grpc.CallOption) (*ApiKeyRevokeResponse, error)
+	// Rotate is a unary RPC to revoke an API key and mint its replacement with the same name and roles.
+	// It requires an ApiKeyRotateRequest and returns an ApiKeyRotateResponse, the only one to carry the new secret.
+	Rotate(ctx context.Context, in *ApiKeyRotateRequest, opts ...grpc.CallOption) (*ApiKeyRotateResponse, error)
+}
+
+type apiKeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApiKeyServiceClient(cc grpc.ClientConnInterface) ApiKeyServiceClient {
+	return &apiKeyServiceClient{cc}
+}
+
+func (c *apiKeyServiceClient) Create(ctx context.Context, in *ApiKeyCreateRequest, opts ...grpc.CallOption) (*ApiKeyCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeyCreateResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) List(ctx context.Context, in *ApiKeyListRequest, opts ...grpc.CallOption) (*ApiKeyListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeyListResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) Revoke(ctx context.Context, in *ApiKeyRevokeRequest, opts ...grpc.CallOption) (*ApiKeyRevokeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeyRevokeResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_Revoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) Rotate(ctx context.Context, in *ApiKeyRotateRequest, opts ...grpc.CallOption) (*ApiKeyRotateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeyRotateResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_Rotate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApiKeyServiceServer is the server API for ApiKeyService service.
+// All implementations must embed UnimplementedApiKeyServiceServer
+// for forward compatibility
+//
+// ApiKeyService is the service for managing the API keys callers authenticate with.
+type ApiKeyServiceServer interface {
+	// Create is a unary RPC to mint a new API key in the tenant of the call.
+	// It requires an ApiKeyCreateRequest and returns an ApiKeyCreateResponse, the only one to carry the secret.
+	Create(context.Context, *ApiKeyCreateRequest) (*ApiKeyCreateResponse, error)
+	// List is a unary RPC to get a list of the API keys of the tenant of the call.
+	// It requires an ApiKeyListRequest and returns an ApiKeyListResponse.
+	List(context.Context, *ApiKeyListRequest) (*ApiKeyListResponse, error)
+	// Revoke is a unary RPC to revoke an API key, its callers are rejected from then on.
+	// It requires an ApiKeyRevokeRequest and returns an ApiKeyRevokeResponse.
+	Revoke(context.Context, *ApiKeyRevokeRequest) (*ApiKeyRevokeResponse, error)
+	// Rotate is a unary RPC to revoke an API key and mint its replacement with the same name and roles.
+	// It requires an ApiKeyRotateRequest and returns an ApiKeyRotateResponse, the only one to carry the new secret.
+	Rotate(context.Context, *ApiKeyRotateRequest) (*ApiKeyRotateResponse, error)
+	mustEmbedUnimplementedApiKeyServiceServer()
+}
+
+// UnimplementedApiKeyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedApiKeyServiceServer struct {
+}
+
+func (UnimplementedApiKeyServiceServer) Create(context.Context, *ApiKeyCreateRequest) (*ApiKeyCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedApiKeyServiceServer) List(context.Context, *ApiKeyListRequest) (*ApiKeyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedApiKeyServiceServer) Revoke(context.Context, *ApiKeyRevokeRequest) (*ApiKeyRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedApiKeyServiceServer) Rotate(context.Context, *ApiKeyRotateRequest) (*ApiKeyRotateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rotate not implemented")
+}
+func (UnimplementedApiKeyServiceServer) mustEmbedUnimplementedApiKeyServiceServer() {}
+
+// UnsafeApiKeyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApiKeyServiceServer will
+// result in compilation errors.
+type UnsafeApiKeyServiceServer interface {
+	mustEmbedUnimplementedApiKeyServiceServer()
+}
+
+func RegisterApiKeyServiceServer(s grpc.ServiceRegistrar, srv ApiKeyServiceServer) {
+	s.RegisterService(&ApiKeyService_ServiceDesc, srv)
+}
+
+func _ApiKeyService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).Create(ctx, req.(*ApiKeyCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKeyService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).List(ctx, req.(*ApiKeyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKeyService_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_Revoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).Revoke(ctx, req.(*ApiKeyRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKeyService_Rotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyRotateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).Rotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_Rotate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).Rotate(ctx, req.(*ApiKeyRotateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ApiKeyService_ServiceDesc is the grpc.ServiceDesc for ApiKeyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ApiKeyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.ApiKeyService",
+	HandlerType: (*ApiKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ApiKeyService_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ApiKeyService_List_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _ApiKeyService_Revoke_Handler,
+		},
+		{
+			MethodName: "Rotate",
+			Handler:    _ApiKeyService_Rotate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/service.proto",
+}
+
+const (
+	AuditService_ListAuditEvents_FullMethodName = "/base.v1.AuditService/ListAuditEvents"
+)
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AuditService is the service for reading the audit trail of the calls that changed data.
+type AuditServiceClient interface {
+	// ListAuditEvents is a unary RPC to get the audit events, latest first.
+	// It requires an AuditEventListRequest and returns an AuditEventListResponse.
+	ListAuditEvents(ctx context.Context, in *AuditEventListRequest, opts ...grpc.CallOption) (*AuditEventListResponse, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) ListAuditEvents(ctx context.Context, in *AuditEventListRequest, opts ...grpc.CallOption) (*AuditEventListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditEventListResponse)
+	err := c.cc.Invoke(ctx, AuditService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+// All implementations must embed UnimplementedAuditServiceServer
+// for forward compatibility
+//
+// AuditService is the service for reading the audit trail of the calls that changed data.
+type AuditServiceServer interface {
+	// ListAuditEvents is a unary RPC to get the audit events, latest first.
+	// It requires an AuditEventListRequest and returns an AuditEventListResponse.
+	ListAuditEvents(context.Context, *AuditEventListRequest) (*AuditEventListResponse, error)
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+// UnimplementedAuditServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAuditServiceServer struct {
+}
+
+func (UnimplementedAuditServiceServer) ListAuditEvents(context.Context, *AuditEventListRequest) (*AuditEventListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {}
+
+// UnsafeAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServiceServer will
+// result in compilation errors.
+type UnsafeAuditServiceServer interface {
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+func RegisterAuditServiceServer(s grpc.ServiceRegistrar, srv AuditServiceServer) {
+	s.RegisterService(&AuditService_ServiceDesc, srv)
+}
+
+func _AuditService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditEventListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, req.(*AuditEventListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AuditService_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
@@ -0,0 +1,60 @@
+package token
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidSignature is returned when a token was not signed by this signer or was modified after signing.
+var ErrInvalidSignature = errors.New("invalid token signature")
+
+// Signer makes opaque tokens tamper-evident by appending an HMAC-SHA256 signature to them.
+type Signer struct {
+	key []byte
+}
+
+// NewSigner - Creates new signer with the given secret
+func NewSigner(secret []byte) *Signer {
+	return &Signer{
+		key: secret,
+	}
+}
+
+// Sign - Returns the value with its signature appended, empty values stay empty
+func (s *Signer) Sign(value string) string {
+	if value == "" {
+		return ""
+	}
+	return value + "." + base64.RawURLEncoding.EncodeToString(s.mac(value))
+}
+
+// Verify - Checks the signature of a signed token and returns the original value
+func (s *Signer) Verify(signed string) (string, error) {
+	i := strings.LastIndexByte(signed, '.')
+	if i < 0 {
+		return "", ErrInvalidSignature
+	}
+
+	value, signature := signed[:i], signed[i+1:]
+
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return "", ErrInvalidSignature
+	}
+
+	if !hmac.Equal(sig, s.mac(value)) {
+		return "", ErrInvalidSignature
+	}
+
+	return value, nil
+}
+
+// mac computes the HMAC-SHA256 of the value.
+func (s *Signer) mac(value string) []byte {
+	h := hmac.New(sha256.New, s.key)
+	h.Write([]byte(value))
+	return h.Sum(nil)
+}
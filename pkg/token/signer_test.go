@@ -0,0 +1,31 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigner(t *testing.T) {
+	signer := NewSigner([]byte("secret"))
+
+	signed := signer.Sign("eyJpIjoxfQ")
+	value, err := signer.Verify(signed)
+	assert.NoError(t, err)
+	assert.Equal(t, "eyJpIjoxfQ", value)
+
+	// empty values have nothing to sign
+	assert.Equal(t, "", signer.Sign(""))
+
+	// modified payload
+	_, err = signer.Verify("eyJpIjoyfQ" + signed[len("eyJpIjoxfQ"):])
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	// missing signature
+	_, err = signer.Verify("eyJpIjoxfQ")
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	// signed with another secret
+	_, err = NewSigner([]byte("other")).Verify(signed)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}